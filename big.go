@@ -0,0 +1,113 @@
+package dbldbl
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// BigFloat sets z to the exact value of n and returns z.
+// If z is nil, a new [big.Float] is allocated.
+//
+// If z's precision is less than 107, or less than needed to represent n exactly,
+// it is increased so that no rounding occurs.
+// BigFloat panics with [big.ErrNaN] if n is a NaN.
+func (n Number) BigFloat(z *big.Float) *big.Float {
+	if z == nil {
+		z = new(big.Float)
+	}
+	if IsNaN(n) {
+		panic(big.ErrNaN{})
+	}
+
+	prec := uint(107)
+	if n.x != 0 {
+		// Bits from the msb of the high part, to the lsb of the low part.
+		_, eh := split(n.y)
+		ml, el := split(n.x)
+		prec = max(prec, uint(eh-el-bits.TrailingZeros64(ml)+54))
+	}
+	if z.Prec() < prec {
+		z.SetPrec(prec)
+	}
+
+	z.SetFloat64(n.y)
+	if n.x != 0 {
+		var t big.Float
+		z.Add(z, t.SetFloat64(n.x))
+	}
+	return z
+}
+
+// FromBigFloat returns the Number nearest to b, and the accuracy of the result:
+// its high part is the float64 nearest to b,
+// and its low part is the float64 nearest to the remainder.
+//
+// If b is too large to be represented, the result is ±Inf.
+// If b is too small to be represented, the result is ±0.
+func FromBigFloat(b *big.Float) (Number, big.Accuracy) {
+	y, acc := b.Float64()
+	if acc == big.Exact || y == 0 || !isFinite(y) {
+		return Number{y: y}, acc
+	}
+
+	// The remainder is exact, since y has the same
+	// exponent as b, give or take one.
+	var t big.Float
+	t.SetPrec(b.Prec() + 64).SetFloat64(y)
+	x, acc := t.Sub(b, &t).Float64()
+	return renormalize(y, x), acc
+}
+
+// Rat returns the exact value of n as a [big.Rat].
+// If n is a NaN or an infinity, Rat returns nil.
+func (n Number) Rat() *big.Rat {
+	if !isFinite(n.y) {
+		return nil
+	}
+	z := new(big.Rat).SetFloat64(n.y)
+	if n.x != 0 {
+		var t big.Rat
+		z.Add(z, t.SetFloat64(n.x))
+	}
+	return z
+}
+
+// FromRat returns the Number nearest to r:
+// its high part is the float64 nearest to r,
+// and its low part is the float64 nearest to the remainder.
+//
+// If r is too large to be represented, the result is ±Inf.
+func FromRat(r *big.Rat) Number {
+	y, exact := r.Float64()
+	if exact || !isFinite(y) {
+		return Number{y: y}
+	}
+
+	var t big.Rat
+	t.SetFloat64(y)
+	x, _ := t.Sub(r, &t).Float64()
+	return renormalize(y, x)
+}
+
+// BigInt returns the result of truncating n towards zero,
+// or nil if n is a NaN or an infinity.
+// The result is [big.Exact] if n is an integer; otherwise it is [big.Below]
+// for n > 0, and [big.Above] for n < 0.
+// If a non-nil *[big.Int] argument z is provided, BigInt stores
+// the result in z instead of allocating a new [big.Int].
+func (n Number) BigInt(z *big.Int) (*big.Int, big.Accuracy) {
+	if IsNaN(n) {
+		return nil, big.Exact
+	}
+	var t big.Float
+	return n.BigFloat(&t).Int(z)
+}
+
+// FromBigInt returns the Number nearest to x.
+//
+// If x is too large to be represented, the result is ±Inf.
+func FromBigInt(x *big.Int) Number {
+	var t big.Float
+	n, _ := FromBigFloat(t.SetInt(x))
+	return n
+}
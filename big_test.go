@@ -0,0 +1,212 @@
+package dbldbl
+
+import (
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+func TestNumber_BigFloat(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+		prec uint
+	}{
+		{Number{}, "0x0p+00", 107},
+		{Number{-zero, 0}, "-0x0p+00", 107},
+		{Number{1, 0x1p-60}, "0x1.000000000000001p+00", 107},
+		{Number{1, -0x1p-60}, "0x1.ffffffffffffffep-01", 107},
+		{Number{0x1p1000, 0x1p-1000}, "0x1." + zeros(499) + "1p+1000", 2002},
+		{Number{math.MaxFloat64, 0x1p-1074}, "0x1.fffffffffffff" + zeros(511) + "8p+1023", 2098},
+		{Pi, "0x1.921fb54442d18469898cc51701cp+01", 107},
+		{Inf(+1), "+Inf", 107},
+		{Inf(-1), "-Inf", 107},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got := tt.arg.BigFloat(nil)
+			if s := got.Text('p', 0); s != new(big.Float).SetPrec(tt.prec).Set(got).Text('p', 0) {
+				t.Fatal(s)
+			}
+			if s := got.Text('x', -1); s != tt.want {
+				t.Errorf("BigFloat() = %s, want %s", s, tt.want)
+			}
+			if got.Prec() < tt.prec {
+				t.Errorf("BigFloat().Prec() = %d, want %d", got.Prec(), tt.prec)
+			}
+			if n, acc := FromBigFloat(got); acc != big.Exact || !same(n, tt.arg) {
+				t.Errorf("FromBigFloat() = %#v, %v, want %#v", n, acc, tt.arg)
+			}
+		})
+	}
+}
+
+func TestNumber_BigFloat_prec(t *testing.T) {
+	z := new(big.Float).SetPrec(300).SetMode(big.ToZero)
+	if got := Pi.BigFloat(z); got != z || z.Prec() != 300 || z.Mode() != big.ToZero {
+		t.Errorf("BigFloat() = %v, %v", z.Prec(), z.Mode())
+	}
+	z.SetPrec(10)
+	if Sqrt2.BigFloat(z); z.Prec() != 107 {
+		t.Errorf("BigFloat() = %v", z.Prec())
+	}
+}
+
+func TestFromBigFloat(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want Number
+		acc  big.Accuracy
+	}{
+		{"0.1", parse("0.1"), big.Below},
+		{"-0.1", Neg(parse("0.1")), big.Above},
+		{"0x1.00000000000000000000000000000001p0", Number{1, 0x1p-128}, big.Exact},
+		{"0x1.000000000000080000000000000001p0", Number{1, 0x1p-53}, big.Below},
+		{"0x1.00000000000017fffffffffffffp0", Number{1 + 0x1p-51, -0x1p-53}, big.Above},
+		{"0x1.00000000000008p-1022", Number{0x1p-1022, 0}, big.Below},
+		{"0x1.0000000000000cp-1022", Number{0x1p-1022 + 0x1p-1074, 0}, big.Above},
+		{"0x1p-1075", Number{}, big.Below},
+		{"-0x1.1p-1075", Number{-0x1p-1074, 0}, big.Below},
+		{"-0x1p-100000", Number{-zero, 0}, big.Above},
+		{"0x1.fffffffffffff7ffffffp1023", Number{math.MaxFloat64, 0x1.ffffffcp969}, big.Exact},
+		{"0x1.fffffffffffff7fffffffffffffffffp1023", Inf(+1), big.Above},
+		{"-0x1.fffffffffffff7ffffffffffffp1023", Number{-math.MaxFloat64, -0x1.ffffffffffffcp969}, big.Exact},
+		{"0x1.fffffffffffff8p1023", Inf(+1), big.Above},
+		{"-0x1p100000", Inf(-1), big.Below},
+		{"+Inf", Inf(+1), big.Exact},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			b, _, err := big.ParseFloat(tt.arg, 0, 1000, big.ToNearestEven)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, acc := FromBigFloat(b)
+			if !same(got, tt.want) || acc != tt.acc {
+				t.Errorf("FromBigFloat() = %#v, %v, want %#v, %v", got, acc, tt.want, tt.acc)
+			}
+		})
+	}
+}
+
+func zeros(n int) string {
+	return strings.Repeat("0", n)
+}
+
+func TestNumber_Rat(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+	}{
+		{Number{}, "0/1"},
+		{Number{-zero, 0}, "0/1"},
+		{Number{1.5, 0}, "3/2"},
+		{Number{1, -0x1p-60}, "1152921504606846975/1152921504606846976"},
+		{Number{0x1p100, 3}, "1267650600228229401496703205379/1"},
+		{Number{0x1p-1074, 0}, "1/" + new(big.Int).Lsh(big.NewInt(1), 1074).String()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			r := tt.arg.Rat()
+			if got := r.String(); got != tt.want {
+				t.Errorf("Rat() = %s, want %s", got, tt.want)
+			}
+			if n := FromRat(r); !same(n, Abs(tt.arg)) {
+				t.Errorf("FromRat() = %#v, want %#v", n, tt.arg)
+			}
+		})
+	}
+	for _, n := range []Number{NaN(), Inf(+1), Inf(-1)} {
+		if r := n.Rat(); r != nil {
+			t.Errorf("Rat(%v) = %v, want nil", n, r)
+		}
+	}
+}
+
+func TestFromRat(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want Number
+	}{
+		{"1/3", parse("0.33333333333333333333333333333333333333333333")},
+		{"-2/3", Neg(parse("0.66666666666666666666666666666666666666666666"))},
+		{"1/10", parse("0.1")},
+		{"22/7", parse("3.14285714285714285714285714285714285714285714")},
+		{"1/" + new(big.Int).Lsh(big.NewInt(1), 1075).String(), Number{}},
+		{new(big.Int).Lsh(big.NewInt(1), 1024).String(), Inf(+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			r, _ := new(big.Rat).SetString(tt.arg)
+			if got := FromRat(r); !same(got, tt.want) {
+				t.Errorf("FromRat() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumber_BigInt(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+		acc  big.Accuracy
+	}{
+		{Number{}, "0", big.Exact},
+		{Number{2.5, 0}, "2", big.Below},
+		{Number{-2.5, 0}, "-2", big.Above},
+		{Number{3, -0x1p-60}, "2", big.Below},
+		{Number{-3, 0x1p-60}, "-2", big.Above},
+		{Number{0x1p100, -1}, "1267650600228229401496703205375", big.Exact},
+		{Number{0x1p100, 0.5}, "1267650600228229401496703205376", big.Below},
+		{Number{-0x1p100, -0x1p47}, "-1267650600228229542234191560704", big.Exact},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			z, acc := tt.arg.BigInt(nil)
+			if got := z.String(); got != tt.want || acc != tt.acc {
+				t.Errorf("BigInt() = %s, %v, want %s, %v", got, acc, tt.want, tt.acc)
+			}
+			if acc == big.Exact {
+				if n := FromBigInt(z); !same(n, tt.arg) {
+					t.Errorf("FromBigInt() = %#v, want %#v", n, tt.arg)
+				}
+			}
+		})
+	}
+
+	z := new(big.Int)
+	if got, _ := Pi.BigInt(z); got != z || z.Int64() != 3 {
+		t.Errorf("BigInt() = %v", z)
+	}
+	if got, acc := Inf(-1).BigInt(nil); got != nil || acc != big.Above {
+		t.Errorf("BigInt() = %v, %v", got, acc)
+	}
+	if got, _ := NaN().BigInt(nil); got != nil {
+		t.Errorf("BigInt() = %v", got)
+	}
+}
+
+func TestFromBigInt(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want Number
+	}{
+		{"0", Number{}},
+		{"-1", Number{-1, 0}},
+		{"1000000000000000000000000000000000", parse("1e33")},
+		{"1267650600228229401496703205377", Number{0x1p100, 1}},
+		{"-1267650600228229401496703205377", Number{-0x1p100, -1}},
+		{"162259276829213363391578010288129", Number{0x1p107, 1}},
+		{"1606938044258990275541962092342430253122431223184289538506753", Number{0x1p200, 0x1p100}},
+		{new(big.Int).Lsh(big.NewInt(1), 1024).String(), Inf(+1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			x, _ := new(big.Int).SetString(tt.arg, 10)
+			if got := FromBigInt(x); !same(got, tt.want) {
+				t.Errorf("FromBigInt() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
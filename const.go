@@ -27,5 +27,6 @@ var (
 
 	twoPi   = Number{Pi.y * 2, Pi.x * 2}
 	halfPi  = Number{Pi.y / 2, Pi.x / 2}
-	twoOfPi = Number{0.6366197723675814, -0x1.6b01ec5417056p-55} // https://oeis.org/A060294
+	twoOfPi = Number{0.6366197723675814, -0x1.6b01ec5417056p-55}   // https://oeis.org/A060294
+	degree  = Number{0.017453292519943295, +0x1.5c1d8becdd291p-62} // https://oeis.org/A019685
 )
@@ -0,0 +1,701 @@
+package dbldbl
+
+import (
+	"math"
+	"math/big"
+	"strings"
+)
+
+// Condition is a set of the floating-point exceptions of IEEE 754,
+// as raised by the operations of a [Context].
+type Condition uint8
+
+const (
+	// Invalid is raised by operations with no meaningful result,
+	// such as Log(-1), or Sub(Inf, Inf), which return NaN.
+	// Operations on NaN return NaN without raising it.
+	Invalid Condition = 1 << iota
+	// DivByZero is raised by operations with an exact infinite result
+	// from finite operands, such as Div(1, 0), or Log(0).
+	DivByZero
+	// Overflow is raised by operations with a finite result
+	// too large to represent, which return ±Inf.
+	Overflow
+	// Underflow is raised by operations with an inexact result
+	// smaller in magnitude than 2⁻⁹⁶⁸, below which a Number
+	// has less than full precision.
+	Underflow
+	// Inexact is raised by operations with a rounded result,
+	// including those that overflow or underflow.
+	Inexact
+)
+
+// String returns the names of the conditions in c, separated by commas.
+func (c Condition) String() string {
+	var names []string
+	for i, name := range [...]string{
+		"invalid operation",
+		"division by zero",
+		"overflow",
+		"underflow",
+		"inexact",
+	} {
+		if c&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// TrapError is the error returned by the methods of a [Context]
+// when an operation raises a condition that it traps.
+type TrapError struct {
+	Op   string    // the operation, such as "Log"
+	Cond Condition // the conditions raised by the operation
+}
+
+func (e *TrapError) Error() string {
+	return "dbldbl: " + e.Op + ": " + e.Cond.String()
+}
+
+// Context tracks the floating-point exceptions raised by its operations.
+//
+// Its methods return the same results as the functions of the same name,
+// and add the conditions they raise to Flags, which are sticky:
+// they're only cleared by the caller.
+// If an operation raises a condition in Traps, it also returns a [*TrapError].
+//
+// The zero value is a Context with no flags raised and no traps.
+// A Context must not be used concurrently.
+type Context struct {
+	Flags Condition // conditions raised so far
+	Traps Condition // conditions that return an error
+}
+
+// result raises the conditions of the operation op,
+// which returned r from args, and is exact if exact is true.
+func (c *Context) result(op string, r Number, exact bool, args ...Number) (Number, error) {
+	return r, c.raise(op, conditions(r, exact, args...))
+}
+
+// raise adds cond to the flags of c,
+// and returns an error if c traps any of them.
+func (c *Context) raise(op string, cond Condition) error {
+	c.Flags |= cond
+	if cond&c.Traps != 0 {
+		return &TrapError{Op: op, Cond: cond}
+	}
+	return nil
+}
+
+// conditions returns the conditions raised by an operation
+// that returned r from args, and is exact if exact is true.
+func conditions(r Number, exact bool, args ...Number) Condition {
+	switch {
+	case IsNaN(r):
+		for _, a := range args {
+			if IsNaN(a) {
+				return 0
+			}
+		}
+		return Invalid
+	case IsInf(r, 0):
+		for _, a := range args {
+			if !isFinite(a.y) {
+				return 0
+			}
+		}
+		if exact {
+			return DivByZero
+		}
+		return Overflow | Inexact
+	case !exact:
+		if math.Abs(r.y) < 0x1p-968 {
+			return Underflow | Inexact
+		}
+		return Inexact
+	}
+	return 0
+}
+
+// Add returns Add(a, b), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) Add(a, b Number) (Number, error) {
+	r := Add(a, b)
+	return c.result("Add", r, exactAdd(a, b, r), a, b)
+}
+
+// Sub returns Sub(a, b), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) Sub(a, b Number) (Number, error) {
+	r := Sub(a, b)
+	return c.result("Sub", r, exactAdd(a, Neg(b), r), a, b)
+}
+
+// AddFloat returns AddFloat(a, b), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) AddFloat(a Number, b float64) (Number, error) {
+	r := AddFloat(a, b)
+	return c.result("AddFloat", r, exactAdd(a, Float(b), r), a, Float(b))
+}
+
+// SubFloat returns SubFloat(a, b), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) SubFloat(a float64, b Number) (Number, error) {
+	r := SubFloat(a, b)
+	return c.result("SubFloat", r, exactAdd(Float(a), Neg(b), r), Float(a), b)
+}
+
+// Fdim returns Fdim(a, b), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) Fdim(a, b Number) (Number, error) {
+	r := Fdim(a, b)
+	exact := r.y == 0 || exactAdd(a, Neg(b), r)
+	return c.result("Fdim", r, exact, a, b)
+}
+
+// Mul returns Mul(a, b), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) Mul(a, b Number) (Number, error) {
+	r := Mul(a, b)
+	return c.result("Mul", r, exactMul(a, b, r), a, b)
+}
+
+// MulFloat returns MulFloat(a, b), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) MulFloat(a Number, b float64) (Number, error) {
+	r := MulFloat(a, b)
+	return c.result("MulFloat", r, exactMul(a, Float(b), r), a, Float(b))
+}
+
+// MulExact returns MulExact(a, b), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) MulExact(a, b Number) (Number, error) {
+	r := MulExact(a, b)
+	return c.result("MulExact", r, exactMul(a, b, r), a, b)
+}
+
+// Div returns Div(a, b), raising any condition.
+func (c *Context) Div(a, b Number) (Number, error) {
+	r := Div(a, b)
+	return c.result("Div", r, exactDiv(a, b, r), a, b)
+}
+
+// DivExact returns DivExact(a, b), raising any condition.
+func (c *Context) DivExact(a, b Number) (Number, error) {
+	r := DivExact(a, b)
+	return c.result("DivExact", r, exactDiv(a, b, r), a, b)
+}
+
+// Sqr returns Sqr(n), raising Overflow, Underflow, or Inexact.
+func (c *Context) Sqr(n Number) (Number, error) {
+	r := Sqr(n)
+	return c.result("Sqr", r, exactMul(n, n, r), n)
+}
+
+// Inv returns Inv(n), raising DivByZero, Overflow, Underflow, or Inexact.
+func (c *Context) Inv(n Number) (Number, error) {
+	r := Inv(n)
+	return c.result("Inv", r, exactDiv(Float(1), n, r), n)
+}
+
+// FMA returns FMA(a, b, c), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) FMA(a, b, d Number) (Number, error) {
+	r := FMA(a, b, d)
+	return c.result("FMA", r, exactFMA(a, b, d, r), a, b, d)
+}
+
+// FMAFloat returns FMAFloat(a, b, c), raising Invalid, Overflow, Underflow, or Inexact.
+func (c *Context) FMAFloat(a, b float64, d Number) (Number, error) {
+	r := FMAFloat(a, b, d)
+	return c.result("FMAFloat", r, exactFMA(Float(a), Float(b), d, r), Float(a), Float(b), d)
+}
+
+// Sqrt returns Sqrt(n), raising Invalid, or Inexact.
+func (c *Context) Sqrt(n Number) (Number, error) {
+	r := Sqrt(n)
+	return c.result("Sqrt", r, exactSqrt(n, r), n)
+}
+
+// SqrtExact returns SqrtExact(n), raising Invalid, or Inexact.
+func (c *Context) SqrtExact(n Number) (Number, error) {
+	r := SqrtExact(n)
+	return c.result("SqrtExact", r, exactSqrt(n, r), n)
+}
+
+// InvSqrt returns InvSqrt(n), raising Invalid, DivByZero, or Inexact.
+func (c *Context) InvSqrt(n Number) (Number, error) {
+	r := InvSqrt(n)
+	exact := true
+	if r.y != 0 && isFinite(r.y) {
+		s := r.Rat()
+		exact = s.Mul(s, s).Mul(s, n.Rat()).Cmp(big.NewRat(1, 1)) == 0
+	}
+	return c.result("InvSqrt", r, exact, n)
+}
+
+// Cbrt returns Cbrt(n), raising Inexact.
+func (c *Context) Cbrt(n Number) (Number, error) {
+	r := Cbrt(n)
+	exact := true
+	if r.y != 0 && isFinite(r.y) {
+		s, t := r.Rat(), r.Rat()
+		exact = s.Mul(s, t).Mul(s, t).Cmp(n.Rat()) == 0
+	}
+	return c.result("Cbrt", r, exact, n)
+}
+
+// Hypot returns Hypot(a, b), raising Overflow, Underflow, or Inexact.
+func (c *Context) Hypot(a, b Number) (Number, error) {
+	r := Hypot(a, b)
+	return c.result("Hypot", r, exactHypot(r, a, b), a, b)
+}
+
+// Hypot3 returns Hypot3(a, b, c), raising Overflow, Underflow, or Inexact.
+func (c *Context) Hypot3(a, b, d Number) (Number, error) {
+	r := Hypot3(a, b, d)
+	return c.result("Hypot3", r, exactHypot(r, a, b, d), a, b, d)
+}
+
+// Ldexp returns Ldexp(n, i), raising Overflow, Underflow, or Inexact.
+func (c *Context) Ldexp(n Number, i int) (Number, error) {
+	r := Ldexp(n, i)
+	exact := !isFinite(n.y) || isFinite(r.y) && Cmp(Ldexp(r, -i), n) == 0
+	return c.result("Ldexp", r, exact, n)
+}
+
+// Nextafter returns Nextafter(a, b), raising Overflow and Inexact
+// if it steps from a finite a to ±Inf, or Underflow and Inexact
+// if it steps to a result smaller in magnitude than 2⁻⁹⁶⁸, like the C function.
+func (c *Context) Nextafter(a, b Number) (Number, error) {
+	r := Nextafter(a, b)
+	var cond Condition
+	switch {
+	case IsInf(r, 0) && isFinite(a.y):
+		cond = Overflow | Inexact
+	case r != a && math.Abs(r.y) < 0x1p-968:
+		cond = Underflow | Inexact
+	}
+	return r, c.raise("Nextafter", cond)
+}
+
+// Mod returns Mod(a, b), raising Invalid, Underflow, or Inexact.
+func (c *Context) Mod(a, b Number) (Number, error) {
+	r := Mod(a, b)
+	return c.result("Mod", r, exactRem(a, b, r), a, b)
+}
+
+// Remainder returns Remainder(a, b), raising Invalid, Underflow, or Inexact.
+func (c *Context) Remainder(a, b Number) (Number, error) {
+	r := Remainder(a, b)
+	return c.result("Remainder", r, exactRem(a, b, r), a, b)
+}
+
+// Pow returns Pow(b, n), raising any condition.
+func (c *Context) Pow(b, n Number) (Number, error) {
+	r := Pow(b, n)
+	return c.result("Pow", r, exactPow(b, n, r), b, n)
+}
+
+// PowInt returns PowInt(b, n), raising any condition.
+func (c *Context) PowInt(b Number, n int64) (Number, error) {
+	r := PowInt(b, n)
+	return c.result("PowInt", r, exactPow(b, Int(n), r), b)
+}
+
+// Pow10 returns Pow10(i), raising Overflow, Underflow, or Inexact.
+func (c *Context) Pow10(i int) (Number, error) {
+	r := Pow10(i)
+	return c.result("Pow10", r, exactPow(Float(10), Int(int64(i)), r))
+}
+
+// Exp returns Exp(n), raising Overflow, Underflow, or Inexact.
+func (c *Context) Exp(n Number) (Number, error) {
+	return c.elementary("Exp", Exp, n)
+}
+
+// Expm1 returns Expm1(n), raising Overflow, Underflow, or Inexact.
+func (c *Context) Expm1(n Number) (Number, error) {
+	return c.elementary("Expm1", Expm1, n)
+}
+
+// Log returns Log(n), raising Invalid, DivByZero, or Inexact.
+func (c *Context) Log(n Number) (Number, error) {
+	return c.elementary("Log", Log, n)
+}
+
+// Log1p returns Log1p(n), raising Invalid, DivByZero, Underflow, or Inexact.
+func (c *Context) Log1p(n Number) (Number, error) {
+	return c.elementary("Log1p", Log1p, n)
+}
+
+// Log2 returns Log2(n), raising Invalid, DivByZero, or Inexact.
+func (c *Context) Log2(n Number) (Number, error) {
+	r := Log2(n)
+	return c.result("Log2", r, exactPow(Float(2), r, n), n)
+}
+
+// Log10 returns Log10(n), raising Invalid, DivByZero, or Inexact.
+func (c *Context) Log10(n Number) (Number, error) {
+	r := Log10(n)
+	return c.result("Log10", r, exactPow(Float(10), r, n), n)
+}
+
+// Exp2 returns Exp2(n), raising Overflow, Underflow, or Inexact.
+func (c *Context) Exp2(n Number) (Number, error) {
+	r := Exp2(n)
+	return c.result("Exp2", r, exactPow(Float(2), n, r), n)
+}
+
+// Exp10 returns Exp10(n), raising Overflow, Underflow, or Inexact.
+func (c *Context) Exp10(n Number) (Number, error) {
+	r := Exp10(n)
+	return c.result("Exp10", r, exactPow(Float(10), n, r), n)
+}
+
+// Sin returns Sin(n), raising Invalid, Underflow, or Inexact.
+func (c *Context) Sin(n Number) (Number, error) {
+	return c.elementary("Sin", Sin, n)
+}
+
+// Cos returns Cos(n), raising Invalid, or Inexact.
+func (c *Context) Cos(n Number) (Number, error) {
+	return c.elementary("Cos", Cos, n)
+}
+
+// Sincos returns Sincos(n), raising Invalid, Underflow, or Inexact.
+func (c *Context) Sincos(n Number) (sin, cos Number, err error) {
+	sin, cos = Sincos(n)
+	cond := conditions(sin, exactElementary(n, sin), n) |
+		conditions(cos, exactElementary(n, cos), n)
+	return sin, cos, c.raise("Sincos", cond)
+}
+
+// Tan returns Tan(n), raising Invalid, Underflow, or Inexact.
+func (c *Context) Tan(n Number) (Number, error) {
+	return c.elementary("Tan", Tan, n)
+}
+
+// Asin returns Asin(n), raising Invalid, Underflow, or Inexact.
+func (c *Context) Asin(n Number) (Number, error) {
+	return c.elementary("Asin", Asin, n)
+}
+
+// Acos returns Acos(n), raising Invalid, or Inexact.
+func (c *Context) Acos(n Number) (Number, error) {
+	return c.elementary("Acos", Acos, n)
+}
+
+// Atan returns Atan(n), raising Underflow, or Inexact.
+func (c *Context) Atan(n Number) (Number, error) {
+	return c.elementary("Atan", Atan, n)
+}
+
+// Atan2 returns Atan2(y, x), raising Underflow, or Inexact.
+func (c *Context) Atan2(y, x Number) (Number, error) {
+	r := Atan2(y, x)
+	// The arc tangent is exact only if it's zero, or NaN.
+	exact := IsNaN(r) || r.y == 0 && (y.y == 0 || IsInf(x, +1))
+	return c.result("Atan2", r, exact, y, x)
+}
+
+// Sinpi returns Sinpi(n), raising Invalid, Underflow, or Inexact.
+func (c *Context) Sinpi(n Number) (Number, error) {
+	r := Sinpi(n)
+	return c.result("Sinpi", r, isMultiple(n, 0.5), n)
+}
+
+// Cospi returns Cospi(n), raising Invalid, or Inexact.
+func (c *Context) Cospi(n Number) (Number, error) {
+	r := Cospi(n)
+	return c.result("Cospi", r, isMultiple(n, 0.5), n)
+}
+
+// Sincospi returns Sincospi(n), raising Invalid, Underflow, or Inexact.
+func (c *Context) Sincospi(n Number) (sin, cos Number, err error) {
+	sin, cos = Sincospi(n)
+	exact := isMultiple(n, 0.5)
+	cond := conditions(sin, exact, n) | conditions(cos, exact, n)
+	return sin, cos, c.raise("Sincospi", cond)
+}
+
+// Tanpi returns Tanpi(n), raising Invalid, DivByZero, Underflow, or Inexact.
+func (c *Context) Tanpi(n Number) (Number, error) {
+	r := Tanpi(n)
+	return c.result("Tanpi", r, isMultiple(n, 0.25), n)
+}
+
+// Sind returns Sind(n), raising Invalid, Underflow, or Inexact.
+func (c *Context) Sind(n Number) (Number, error) {
+	r := Sind(n)
+	return c.result("Sind", r, exactDegrees(n, r), n)
+}
+
+// Cosd returns Cosd(n), raising Invalid, or Inexact.
+func (c *Context) Cosd(n Number) (Number, error) {
+	r := Cosd(n)
+	return c.result("Cosd", r, exactDegrees(n, r), n)
+}
+
+// Asinpi returns Asinpi(n), raising Invalid, Underflow, or Inexact.
+func (c *Context) Asinpi(n Number) (Number, error) {
+	r := Asinpi(n)
+	exact := n.y == 0 || math.Abs(n.y) == 1 && n.x == 0
+	return c.result("Asinpi", r, exact, n)
+}
+
+// Acospi returns Acospi(n), raising Invalid, or Inexact.
+func (c *Context) Acospi(n Number) (Number, error) {
+	r := Acospi(n)
+	exact := n.y == 0 || math.Abs(n.y) == 1 && n.x == 0
+	return c.result("Acospi", r, exact, n)
+}
+
+// Atanpi returns Atanpi(n), raising Underflow, or Inexact.
+func (c *Context) Atanpi(n Number) (Number, error) {
+	r := Atanpi(n)
+	return c.result("Atanpi", r, exactAtan2pi(n, Float(1)), n)
+}
+
+// Atan2pi returns Atan2pi(y, x), raising Underflow, or Inexact.
+func (c *Context) Atan2pi(y, x Number) (Number, error) {
+	r := Atan2pi(y, x)
+	return c.result("Atan2pi", r, exactAtan2pi(y, x), y, x)
+}
+
+// Atan2d returns Atan2d(y, x), raising Underflow, or Inexact.
+func (c *Context) Atan2d(y, x Number) (Number, error) {
+	r := Atan2d(y, x)
+	return c.result("Atan2d", r, exactAtan2pi(y, x), y, x)
+}
+
+// Sinh returns Sinh(n), raising Overflow, Underflow, or Inexact.
+func (c *Context) Sinh(n Number) (Number, error) {
+	return c.elementary("Sinh", Sinh, n)
+}
+
+// Cosh returns Cosh(n), raising Overflow, or Inexact.
+func (c *Context) Cosh(n Number) (Number, error) {
+	return c.elementary("Cosh", Cosh, n)
+}
+
+// Tanh returns Tanh(n), raising Underflow, or Inexact.
+func (c *Context) Tanh(n Number) (Number, error) {
+	return c.elementary("Tanh", Tanh, n)
+}
+
+// Asinh returns Asinh(n), raising Underflow, or Inexact.
+func (c *Context) Asinh(n Number) (Number, error) {
+	return c.elementary("Asinh", Asinh, n)
+}
+
+// Acosh returns Acosh(n), raising Invalid, or Inexact.
+func (c *Context) Acosh(n Number) (Number, error) {
+	return c.elementary("Acosh", Acosh, n)
+}
+
+// Atanh returns Atanh(n), raising Invalid, DivByZero, Underflow, or Inexact.
+func (c *Context) Atanh(n Number) (Number, error) {
+	return c.elementary("Atanh", Atanh, n)
+}
+
+// elementary returns f(n), where f is a transcendental function.
+func (c *Context) elementary(op string, f func(Number) Number, n Number) (Number, error) {
+	r := f(n)
+	return c.result(op, r, exactElementary(n, r), n)
+}
+
+// exactElementary reports whether r = f(n) is exact,
+// where f is a transcendental function.
+func exactElementary(n, r Number) bool {
+	// Transcendental functions are transcendental
+	// for all nonzero algebraic arguments, except
+	// those where they're zero, or have a pole:
+	// Log(1), Acos(1), Acosh(1), Log1p(-1), Atanh(±1).
+	// Their limits at infinity are exact if they're 0, ±1, or ±Inf.
+	switch {
+	case IsNaN(r):
+		return true
+	case n.y == 0 || !isFinite(n.y):
+		return r.y == 0 || IsInf(r, 0) || math.Abs(r.y) == 1 && r.x == 0
+	case math.Abs(n.y) == 1 && n.x == 0:
+		return r.y == 0 || IsInf(r, 0)
+	}
+	return false
+}
+
+// isMultiple reports whether n is a multiple of m, or not finite.
+func isMultiple(n Number, m float64) bool {
+	return !isFinite(n.y) || Mod(n, Float(m)).y == 0
+}
+
+// exactDegrees reports whether r = f(n) is exact,
+// where f is the sine or cosine of the degree argument n.
+func exactDegrees(n, r Number) bool {
+	// The sine and cosine are rational only for multiples of 30°,
+	// and only if they're 0, ±½, or ±1.
+	a := math.Abs(r.y)
+	return isMultiple(n, 30) && r.x == 0 && (a == 0 || a == 0.5 || a == 1)
+}
+
+// exactAtan2pi reports whether Atan2pi(y, x) is exact.
+func exactAtan2pi(y, x Number) bool {
+	// The arc tangent is a rational multiple of π only
+	// if it's a multiple of π/4.
+	return y.y == 0 || x.y == 0 || !isFinite(y.y) || !isFinite(x.y) || Abs(y) == Abs(x)
+}
+
+// exactAdd reports whether s = a + b is exact.
+func exactAdd(a, b, s Number) bool {
+	if !isFinite(s.y) {
+		return !isFinite(a.y) || !isFinite(b.y)
+	}
+	r := addResidual(a, b, s)
+	return r.n == 0
+}
+
+// exactMul reports whether s = a⋅b is exact.
+func exactMul(a, b, s Number) bool {
+	if !isFinite(s.y) {
+		return !isFinite(a.y) || !isFinite(b.y)
+	}
+	r := mulResidual(a, b, s)
+	return r.isZero(func() bool {
+		t := a.Rat()
+		return t.Mul(t, b.Rat()).Cmp(s.Rat()) == 0
+	})
+}
+
+// exactFMA reports whether r = a⋅b + d is exact.
+func exactFMA(a, b, d, r Number) bool {
+	if !isFinite(r.y) {
+		return !isFinite(a.y) || !isFinite(b.y) || !isFinite(d.y)
+	}
+	var e expansion
+	e.mulAdd(a.y, b.y)
+	e.mulAdd(a.y, b.x)
+	e.mulAdd(a.x, b.y)
+	e.mulAdd(a.x, b.x)
+	e.add(d.y)
+	e.add(d.x)
+	e.add(-r.y)
+	e.add(-r.x)
+	return e.isZero(func() bool {
+		var t big.Rat
+		t.Mul(a.Rat(), b.Rat())
+		return t.Add(&t, d.Rat()).Cmp(r.Rat()) == 0
+	})
+}
+
+// exactDiv reports whether s = a/b is exact.
+func exactDiv(a, b, s Number) bool {
+	switch {
+	case !isFinite(a.y) || !isFinite(b.y) || b.y == 0:
+		return true
+	case !isFinite(s.y):
+		return false
+	}
+	r, _ := divResidual(a, b, s)
+	return r.isZero(func() bool {
+		t := s.Rat()
+		return t.Mul(t, b.Rat()).Cmp(a.Rat()) == 0
+	})
+}
+
+// exactSqrt reports whether r = √n is exact.
+func exactSqrt(n, r Number) bool {
+	if r.y == 0 || !isFinite(r.y) {
+		return true
+	}
+	e, _ := sqrtResidual(n, r)
+	return e.isZero(func() bool {
+		s := r.Rat()
+		return s.Mul(s, s).Cmp(n.Rat()) == 0
+	})
+}
+
+// exactHypot reports whether r is the square root
+// of the sum of the squares of args.
+func exactHypot(r Number, args ...Number) bool {
+	if !isFinite(r.y) {
+		for _, a := range args {
+			if !isFinite(a.y) {
+				return true
+			}
+		}
+		return false
+	}
+	var t, s big.Rat
+	for _, a := range args {
+		q := a.Rat()
+		t.Add(&t, q.Mul(q, q))
+	}
+	q := r.Rat()
+	return s.Mul(q, q).Cmp(&t) == 0
+}
+
+// exactRem reports whether r is the exact remainder of a/b.
+func exactRem(a, b, r Number) bool {
+	if !isFinite(a.y) || !isFinite(b.y) || b.y == 0 || IsNaN(r) {
+		return true
+	}
+	// The exact remainder is a - q⋅b, for some integer q,
+	// and differs from r by less than |b|.
+	t := a.Rat()
+	t.Sub(t, r.Rat())
+	return t.Quo(t, b.Rat()).IsInt()
+}
+
+// exactPow reports whether r = bⁿ is exact.
+func exactPow(b, n, r Number) bool {
+	switch {
+	case b.y == 0 || n.y == 0 || !isFinite(b.y) || !isFinite(n.y) || IsNaN(r):
+		return true
+	case r.y == 0 || !isFinite(r.y):
+		return false
+	}
+
+	// |b| = mb⋅2ᵉᵇ, |r| = mr⋅2ᵉʳ, and n = p/2ᵏ,
+	// with mb, mr, and p odd integers, and k ≥ 0.
+	var mb, mr, p big.Int
+	eb := oddScaled(&mb, b)
+	er := oddScaled(&mr, r)
+	k := -oddScaled(&p, n)
+	if k < 0 {
+		p.Lsh(&p, uint(-k))
+		k = 0
+	}
+	mb.Abs(&mb)
+	mr.Abs(&mr)
+
+	// |r| = |b|ⁿ, if mr²ᵏ = mbᵖ, and er⋅2ᵏ = eb⋅p.
+	var t, s big.Int
+	t.SetInt64(int64(eb)).Mul(&t, &p)
+	s.SetInt64(int64(er)).Lsh(&s, uint(k))
+	if t.Cmp(&s) != 0 {
+		return false
+	}
+	if mb.BitLen() == 1 {
+		return mr.BitLen() == 1
+	}
+	if p.Sign() < 0 {
+		return false // mbᵖ isn't an integer
+	}
+
+	// mb is the 2ᵏ-th power of an odd integer t,
+	// and mr = tᵖ, which must fit in a Number.
+	t.Set(&mb)
+	for range k {
+		s.Sqrt(&t)
+		if s.Mul(&s, &s).Cmp(&t) != 0 {
+			return false
+		}
+		t.Sqrt(&t)
+	}
+	if p.BitLen() > 12 {
+		return false
+	}
+	return t.Exp(&t, &p, nil).Cmp(&mr) == 0
+}
+
+// oddScaled sets z to the odd integer n⋅2⁻ᵉ, and returns e,
+// for a nonzero, finite n.
+func oddScaled(z *big.Int, n Number) int {
+	e := quantum(n)
+	setScaled(z, n, e)
+	tz := z.TrailingZeroBits()
+	z.Rsh(z, tz)
+	return e + int(tz)
+}
@@ -0,0 +1,364 @@
+package dbldbl
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	max := Number{math.MaxFloat64, 0x1p970 - 0x1p917}
+	tests := []struct {
+		name string
+		op   func(c *Context) (Number, error)
+		want Condition
+	}{
+		{"Add(1, 2)", func(c *Context) (Number, error) { return c.Add(Float(1), Float(2)) }, 0},
+		{"Add(1, 2⁻²⁰⁰)", func(c *Context) (Number, error) { return c.Add(Float(1), Float(0x1p-200)) }, 0},
+		{"Add(1+2⁻⁶⁰, 2⁻²⁰⁰)", func(c *Context) (Number, error) { return c.Add(Number{1, 0x1p-60}, Float(0x1p-200)) }, Inexact},
+		{"Add(max, max)", func(c *Context) (Number, error) { return c.Add(max, max) }, Overflow | Inexact},
+		{"Add(Inf, 1)", func(c *Context) (Number, error) { return c.Add(Inf(+1), Float(1)) }, 0},
+		{"Sub(Inf, Inf)", func(c *Context) (Number, error) { return c.Sub(Inf(+1), Inf(+1)) }, Invalid},
+		{"Sub(NaN, 1)", func(c *Context) (Number, error) { return c.Sub(NaN(), Float(1)) }, 0},
+		{"AddFloat(1+2⁻⁶⁰, 2⁻²⁰⁰)", func(c *Context) (Number, error) { return c.AddFloat(Number{1, 0x1p-60}, 0x1p-200) }, Inexact},
+		{"AddFloat(max, max)", func(c *Context) (Number, error) { return c.AddFloat(max, math.MaxFloat64) }, Overflow | Inexact},
+		{"SubFloat(1, 2⁻²⁰⁰)", func(c *Context) (Number, error) { return c.SubFloat(1, Float(0x1p-200)) }, 0},
+		{"SubFloat(Inf, Inf)", func(c *Context) (Number, error) { return c.SubFloat(math.Inf(1), Inf(+1)) }, Invalid},
+		{"Fdim(1, 3)", func(c *Context) (Number, error) { return c.Fdim(Float(1), Float(3)) }, 0},
+		{"Fdim(1+2⁻⁶⁰, -2⁻²⁰⁰)", func(c *Context) (Number, error) { return c.Fdim(Number{1, 0x1p-60}, Float(-0x1p-200)) }, Inexact},
+		{"Fdim(Inf, Inf)", func(c *Context) (Number, error) { return c.Fdim(Inf(+1), Inf(+1)) }, Invalid},
+		{"Mul(Inf, 0)", func(c *Context) (Number, error) { return c.Mul(Inf(+1), Float(0)) }, Invalid},
+		{"Mul(1+2⁻⁵², 1+2⁻⁵²)", func(c *Context) (Number, error) { return c.Mul(Float(1+0x1p-52), Float(1+0x1p-52)) }, 0},
+		{"Mul(2⁻⁵⁰⁰, 2⁻⁵⁰⁰)", func(c *Context) (Number, error) { return c.Mul(Float(0x1p-500), Float(0x1p-500)) }, 0},
+		{"Mul(3⋅2⁻⁵⁰⁰, 3⋅2⁻⁵⁰⁰)", func(c *Context) (Number, error) { return c.Mul(Float(3*0x1p-500), Float(3*0x1p-500)) }, 0},
+		{"Mul(2⁻⁶⁰⁰, 2⁻⁶⁰⁰)", func(c *Context) (Number, error) { return c.Mul(Float(0x1p-600), Float(0x1p-600)) }, Underflow | Inexact},
+		{"Mul(1/3, 2⁻⁹⁹⁰)", func(c *Context) (Number, error) { return c.Mul(Div(Float(1), Float(3)), Float(0x1p-990)) }, Underflow | Inexact},
+		{"MulFloat(1/3, 0.1)", func(c *Context) (Number, error) { return c.MulFloat(Div(Float(1), Float(3)), 0.1) }, Inexact},
+		{"MulFloat(1+2⁻⁵², 1+2⁻⁵²)", func(c *Context) (Number, error) { return c.MulFloat(Float(1+0x1p-52), 1+0x1p-52) }, 0},
+		{"MulFloat(Inf, 0)", func(c *Context) (Number, error) { return c.MulFloat(Inf(+1), 0) }, Invalid},
+		{"MulExact(1+2⁻⁶⁰, 1+2⁻⁶⁰)", func(c *Context) (Number, error) { return c.MulExact(Number{1, 0x1p-60}, Number{1, 0x1p-60}) }, Inexact},
+		{"MulExact(3, 5)", func(c *Context) (Number, error) { return c.MulExact(Float(3), Float(5)) }, 0},
+		{"MulExact(2⁻⁶⁰⁰, 2⁻⁶⁰⁰)", func(c *Context) (Number, error) { return c.MulExact(Float(0x1p-600), Float(0x1p-600)) }, Underflow | Inexact},
+		{"Div(1, 3)", func(c *Context) (Number, error) { return c.Div(Float(1), Float(3)) }, Inexact},
+		{"Div(6, 3)", func(c *Context) (Number, error) { return c.Div(Float(6), Float(3)) }, 0},
+		{"Div(1, 0)", func(c *Context) (Number, error) { return c.Div(Float(1), Float(0)) }, DivByZero},
+		{"Div(0, 0)", func(c *Context) (Number, error) { return c.Div(Float(0), Float(0)) }, Invalid},
+		{"Div(1, Inf)", func(c *Context) (Number, error) { return c.Div(Float(1), Inf(+1)) }, 0},
+		{"Div(max, 2⁻¹⁰)", func(c *Context) (Number, error) { return c.Div(max, Float(0x1p-10)) }, Overflow | Inexact},
+		{"Div(2⁻¹⁰⁷⁴, 2)", func(c *Context) (Number, error) { return c.Div(Float(0x1p-1074), Float(2)) }, Underflow | Inexact},
+		{"DivExact(1, 3)", func(c *Context) (Number, error) { return c.DivExact(Float(1), Float(3)) }, Inexact},
+		{"DivExact(6, 3)", func(c *Context) (Number, error) { return c.DivExact(Float(6), Float(3)) }, 0},
+		{"DivExact(1, 0)", func(c *Context) (Number, error) { return c.DivExact(Float(1), Float(0)) }, DivByZero},
+		{"DivExact(max, 2⁻¹⁰)", func(c *Context) (Number, error) { return c.DivExact(max, Float(0x1p-10)) }, Overflow | Inexact},
+		{"Sqr(3)", func(c *Context) (Number, error) { return c.Sqr(Float(3)) }, 0},
+		{"Inv(0)", func(c *Context) (Number, error) { return c.Inv(Float(-zero)) }, DivByZero},
+		{"Inv(4)", func(c *Context) (Number, error) { return c.Inv(Float(4)) }, 0},
+		{"FMA(2, 3, 2⁻²⁰⁰)", func(c *Context) (Number, error) { return c.FMA(Float(2), Float(3), Float(0x1p-200)) }, 0},
+		{"FMA(1/3, 3, 1)", func(c *Context) (Number, error) { return c.FMA(Div(Float(1), Float(3)), Float(3), Float(1)) }, Inexact},
+		{"FMA(Inf, 0, 1)", func(c *Context) (Number, error) { return c.FMA(Inf(+1), Float(0), Float(1)) }, Invalid},
+		{"FMAFloat(2, 3, 2⁻²⁰⁰)", func(c *Context) (Number, error) { return c.FMAFloat(2, 3, Float(0x1p-200)) }, 0},
+		{"FMAFloat(0.1, 0.1, 1)", func(c *Context) (Number, error) { return c.FMAFloat(0.1, 0.1, Float(1)) }, Inexact},
+		{"FMAFloat(Inf, 0, 1)", func(c *Context) (Number, error) { return c.FMAFloat(math.Inf(1), 0, Float(1)) }, Invalid},
+		{"Sqrt(4)", func(c *Context) (Number, error) { return c.Sqrt(Float(4)) }, 0},
+		{"Sqrt(2)", func(c *Context) (Number, error) { return c.Sqrt(Float(2)) }, Inexact},
+		{"Sqrt(2⁻¹⁰⁷⁴)", func(c *Context) (Number, error) { return c.Sqrt(Float(0x1p-1074)) }, 0},
+		{"Sqrt(-1)", func(c *Context) (Number, error) { return c.Sqrt(Float(-1)) }, Invalid},
+		{"Sqrt(-0)", func(c *Context) (Number, error) { return c.Sqrt(Float(-zero)) }, 0},
+		{"SqrtExact(4)", func(c *Context) (Number, error) { return c.SqrtExact(Float(4)) }, 0},
+		{"SqrtExact(2)", func(c *Context) (Number, error) { return c.SqrtExact(Float(2)) }, Inexact},
+		{"SqrtExact(-1)", func(c *Context) (Number, error) { return c.SqrtExact(Float(-1)) }, Invalid},
+		{"InvSqrt(4)", func(c *Context) (Number, error) { return c.InvSqrt(Float(4)) }, 0},
+		{"InvSqrt(0)", func(c *Context) (Number, error) { return c.InvSqrt(Float(0)) }, DivByZero},
+		{"Cbrt(27)", func(c *Context) (Number, error) { return c.Cbrt(Float(27)) }, 0},
+		{"Cbrt(2)", func(c *Context) (Number, error) { return c.Cbrt(Float(2)) }, Inexact},
+		{"Hypot(3, 4)", func(c *Context) (Number, error) { return c.Hypot(Float(3), Float(4)) }, 0},
+		{"Hypot(1, 1)", func(c *Context) (Number, error) { return c.Hypot(Float(1), Float(1)) }, Inexact},
+		{"Hypot(max, max)", func(c *Context) (Number, error) { return c.Hypot(max, max) }, Overflow | Inexact},
+		{"Hypot(Inf, NaN)", func(c *Context) (Number, error) { return c.Hypot(Inf(+1), NaN()) }, 0},
+		{"Hypot3(2, 3, 6)", func(c *Context) (Number, error) { return c.Hypot3(Float(2), Float(3), Float(6)) }, 0},
+		{"Ldexp(1+2⁻¹⁰⁰, -1000)", func(c *Context) (Number, error) { return c.Ldexp(Number{1, 0x1p-100}, -1000) }, Underflow | Inexact},
+		{"Ldexp(1, -1074)", func(c *Context) (Number, error) { return c.Ldexp(Float(1), -1074) }, 0},
+		{"Ldexp(1, 1024)", func(c *Context) (Number, error) { return c.Ldexp(Float(1), 1024) }, Overflow | Inexact},
+		{"Nextafter(1, 2)", func(c *Context) (Number, error) { return c.Nextafter(Float(1), Float(2)) }, 0},
+		{"Nextafter(max, Inf)", func(c *Context) (Number, error) { return c.Nextafter(max, Inf(+1)) }, Overflow | Inexact},
+		{"Nextafter(Inf, 0)", func(c *Context) (Number, error) { return c.Nextafter(Inf(+1), Float(0)) }, 0},
+		{"Nextafter(2⁻¹⁰⁷⁴, 0)", func(c *Context) (Number, error) { return c.Nextafter(Float(0x1p-1074), Float(0)) }, Underflow | Inexact},
+		{"Nextafter(NaN, 0)", func(c *Context) (Number, error) { return c.Nextafter(NaN(), Float(0)) }, 0},
+		{"Mod(7, 3)", func(c *Context) (Number, error) { return c.Mod(Float(7), Float(3)) }, 0},
+		{"Mod(7, 0)", func(c *Context) (Number, error) { return c.Mod(Float(7), Float(0)) }, Invalid},
+		{"Remainder(7, 3)", func(c *Context) (Number, error) { return c.Remainder(Float(7), Float(3)) }, 0},
+		{"Pow(3, 4)", func(c *Context) (Number, error) { return c.Pow(Float(3), Float(4)) }, 0},
+		{"Pow(10, 40)", func(c *Context) (Number, error) { return c.Pow(Float(10), Float(40)) }, 0},
+		{"Pow(3, 70)", func(c *Context) (Number, error) { return c.Pow(Float(3), Float(70)) }, Inexact},
+		{"Pow(2, -1074)", func(c *Context) (Number, error) { return c.Pow(Float(2), Float(-1074)) }, 0},
+		{"Pow(2, -1075)", func(c *Context) (Number, error) { return c.Pow(Float(2), Float(-1075)) }, Underflow | Inexact},
+		{"Pow(4, 0.5)", func(c *Context) (Number, error) { return c.Pow(Float(4), Float(0.5)) }, 0},
+		{"Pow(2, 0.5)", func(c *Context) (Number, error) { return c.Pow(Float(2), Float(0.5)) }, Inexact},
+		{"Pow(-2, 0.5)", func(c *Context) (Number, error) { return c.Pow(Float(-2), Float(0.5)) }, Invalid},
+		{"Pow(0, -1)", func(c *Context) (Number, error) { return c.Pow(Float(0), Float(-1)) }, DivByZero},
+		{"Pow(10, 400)", func(c *Context) (Number, error) { return c.Pow(Float(10), Float(400)) }, Overflow | Inexact},
+		{"PowInt(-3, 3)", func(c *Context) (Number, error) { return c.PowInt(Float(-3), 3) }, 0},
+		{"Pow10(40)", func(c *Context) (Number, error) { return c.Pow10(40) }, 0},
+		{"Pow10(-1)", func(c *Context) (Number, error) { return c.Pow10(-1) }, Inexact},
+		{"Pow10(400)", func(c *Context) (Number, error) { return c.Pow10(400) }, Overflow | Inexact},
+		{"Pow10(-400)", func(c *Context) (Number, error) { return c.Pow10(-400) }, Underflow | Inexact},
+		{"Exp(0)", func(c *Context) (Number, error) { return c.Exp(Float(0)) }, 0},
+		{"Exp(1)", func(c *Context) (Number, error) { return c.Exp(Float(1)) }, Inexact},
+		{"Exp(-Inf)", func(c *Context) (Number, error) { return c.Exp(Inf(-1)) }, 0},
+		{"Exp(1000)", func(c *Context) (Number, error) { return c.Exp(Float(1000)) }, Overflow | Inexact},
+		{"Exp(-1000)", func(c *Context) (Number, error) { return c.Exp(Float(-1000)) }, Underflow | Inexact},
+		{"Expm1(-Inf)", func(c *Context) (Number, error) { return c.Expm1(Inf(-1)) }, 0},
+		{"Log(1)", func(c *Context) (Number, error) { return c.Log(Float(1)) }, 0},
+		{"Log(2)", func(c *Context) (Number, error) { return c.Log(Float(2)) }, Inexact},
+		{"Log(0)", func(c *Context) (Number, error) { return c.Log(Float(0)) }, DivByZero},
+		{"Log(-1)", func(c *Context) (Number, error) { return c.Log(Float(-1)) }, Invalid},
+		{"Log(Inf)", func(c *Context) (Number, error) { return c.Log(Inf(+1)) }, 0},
+		{"Log1p(-1)", func(c *Context) (Number, error) { return c.Log1p(Float(-1)) }, DivByZero},
+		{"Log1p(2⁻¹⁰⁰⁰)", func(c *Context) (Number, error) { return c.Log1p(Float(0x1p-1000)) }, Underflow | Inexact},
+		{"Log2(8)", func(c *Context) (Number, error) { return c.Log2(Float(8)) }, 0},
+		{"Log2(3)", func(c *Context) (Number, error) { return c.Log2(Float(3)) }, Inexact},
+		{"Log2(0)", func(c *Context) (Number, error) { return c.Log2(Float(0)) }, DivByZero},
+		{"Log10(1000)", func(c *Context) (Number, error) { return c.Log10(Float(1000)) }, 0},
+		{"Log10(-1)", func(c *Context) (Number, error) { return c.Log10(Float(-1)) }, Invalid},
+		{"Exp2(-1074)", func(c *Context) (Number, error) { return c.Exp2(Float(-1074)) }, 0},
+		{"Exp2(-1075)", func(c *Context) (Number, error) { return c.Exp2(Float(-1075)) }, Underflow | Inexact},
+		{"Exp2(0.5)", func(c *Context) (Number, error) { return c.Exp2(Float(0.5)) }, Inexact},
+		{"Exp10(2)", func(c *Context) (Number, error) { return c.Exp10(Float(2)) }, 0},
+		{"Exp10(-1)", func(c *Context) (Number, error) { return c.Exp10(Float(-1)) }, Inexact},
+		{"Exp10(400)", func(c *Context) (Number, error) { return c.Exp10(Float(400)) }, Overflow | Inexact},
+		{"Sin(0)", func(c *Context) (Number, error) { return c.Sin(Float(0)) }, 0},
+		{"Sin(Inf)", func(c *Context) (Number, error) { return c.Sin(Inf(+1)) }, Invalid},
+		{"Cos(0)", func(c *Context) (Number, error) { return c.Cos(Float(0)) }, 0},
+		{"Tan(1)", func(c *Context) (Number, error) { return c.Tan(Float(1)) }, Inexact},
+		{"Asin(2)", func(c *Context) (Number, error) { return c.Asin(Float(2)) }, Invalid},
+		{"Acos(1)", func(c *Context) (Number, error) { return c.Acos(Float(1)) }, 0},
+		{"Acos(0)", func(c *Context) (Number, error) { return c.Acos(Float(0)) }, Inexact},
+		{"Atan(Inf)", func(c *Context) (Number, error) { return c.Atan(Inf(+1)) }, Inexact},
+		{"Atan2(0, 1)", func(c *Context) (Number, error) { return c.Atan2(Float(0), Float(1)) }, 0},
+		{"Atan2(0, -1)", func(c *Context) (Number, error) { return c.Atan2(Float(0), Float(-1)) }, Inexact},
+		{"Atan2(1, Inf)", func(c *Context) (Number, error) { return c.Atan2(Float(1), Inf(+1)) }, 0},
+		{"Atan2(Inf, Inf)", func(c *Context) (Number, error) { return c.Atan2(Inf(+1), Inf(+1)) }, Inexact},
+		{"Sinpi(1)", func(c *Context) (Number, error) { return c.Sinpi(Float(1)) }, 0},
+		{"Sinpi(⅛)", func(c *Context) (Number, error) { return c.Sinpi(Float(0.125)) }, Inexact},
+		{"Sinpi(2⁻¹⁰⁷⁴)", func(c *Context) (Number, error) { return c.Sinpi(Float(0x1p-1074)) }, Underflow | Inexact},
+		{"Cospi(2⁻⁶⁰)", func(c *Context) (Number, error) { return c.Cospi(Float(0x1p-60)) }, Inexact},
+		{"Tanpi(¼)", func(c *Context) (Number, error) { return c.Tanpi(Float(0.25)) }, 0},
+		{"Tanpi(½)", func(c *Context) (Number, error) { return c.Tanpi(Float(0.5)) }, DivByZero},
+		{"Sind(150)", func(c *Context) (Number, error) { return c.Sind(Float(150)) }, 0},
+		{"Sind(60)", func(c *Context) (Number, error) { return c.Sind(Float(60)) }, Inexact},
+		{"Cosd(Inf)", func(c *Context) (Number, error) { return c.Cosd(Inf(+1)) }, Invalid},
+		{"Asinpi(-1)", func(c *Context) (Number, error) { return c.Asinpi(Float(-1)) }, 0},
+		{"Acospi(½)", func(c *Context) (Number, error) { return c.Acospi(Float(0.5)) }, Inexact},
+		{"Atanpi(Inf)", func(c *Context) (Number, error) { return c.Atanpi(Inf(+1)) }, 0},
+		{"Atan2pi(-2, 2)", func(c *Context) (Number, error) { return c.Atan2pi(Float(-2), Float(2)) }, 0},
+		{"Atan2d(1, 2)", func(c *Context) (Number, error) { return c.Atan2d(Float(1), Float(2)) }, Inexact},
+		{"Sinh(1000)", func(c *Context) (Number, error) { return c.Sinh(Float(1000)) }, Overflow | Inexact},
+		{"Cosh(0)", func(c *Context) (Number, error) { return c.Cosh(Float(0)) }, 0},
+		{"Tanh(Inf)", func(c *Context) (Number, error) { return c.Tanh(Inf(+1)) }, 0},
+		{"Tanh(100)", func(c *Context) (Number, error) { return c.Tanh(Float(100)) }, Inexact},
+		{"Asinh(-Inf)", func(c *Context) (Number, error) { return c.Asinh(Inf(-1)) }, 0},
+		{"Acosh(1)", func(c *Context) (Number, error) { return c.Acosh(Float(1)) }, 0},
+		{"Acosh(0.5)", func(c *Context) (Number, error) { return c.Acosh(Float(0.5)) }, Invalid},
+		{"Atanh(1)", func(c *Context) (Number, error) { return c.Atanh(Float(1)) }, DivByZero},
+		{"Atanh(2)", func(c *Context) (Number, error) { return c.Atanh(Float(2)) }, Invalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var c Context
+			if _, err := tt.op(&c); err != nil {
+				t.Fatal(err)
+			}
+			if c.Flags != tt.want {
+				t.Errorf("Flags = %q, want %q", c.Flags, tt.want)
+			}
+		})
+	}
+}
+
+func TestContext_Sincos(t *testing.T) {
+	var c Context
+	sin, cos, err := c.Sincos(Float(0))
+	if err != nil || !same(sin, Float(0)) || !same(cos, Float(1)) || c.Flags != 0 {
+		t.Errorf("Sincos(0) = %v, %v, %v, %q", sin, cos, err, c.Flags)
+	}
+	sin, cos, err = c.Sincos(Float(1))
+	if want, _ := Sincos(Float(1)); err != nil || !same(sin, want) || c.Flags != Inexact {
+		t.Errorf("Sincos(1) = %v, %v, %v, %q", sin, cos, err, c.Flags)
+	}
+}
+
+func TestContext_traps(t *testing.T) {
+	c := Context{Traps: Invalid | DivByZero}
+
+	// Flags are sticky, and untrapped conditions don't return errors.
+	if r, err := c.Div(Float(1), Float(3)); err != nil || !same(r, Div(Float(1), Float(3))) {
+		t.Fatalf("Div(1, 3) = %v, %v", r, err)
+	}
+	if r, err := c.Add(Float(1), Float(2)); err != nil || !same(r, Float(3)) {
+		t.Fatalf("Add(1, 2) = %v, %v", r, err)
+	}
+	if c.Flags != Inexact {
+		t.Fatalf("Flags = %q", c.Flags)
+	}
+
+	r, err := c.Log(Float(0))
+	if !same(r, Inf(-1)) {
+		t.Errorf("Log(0) = %v", r)
+	}
+	var trap *TrapError
+	if !errors.As(err, &trap) || trap.Op != "Log" || trap.Cond != DivByZero {
+		t.Fatalf("Log(0) = %v", err)
+	}
+	if got, want := err.Error(), "dbldbl: Log: division by zero"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if c.Flags != Inexact|DivByZero {
+		t.Errorf("Flags = %q", c.Flags)
+	}
+
+	c.Flags = 0
+	if _, err := c.Sqrt(Float(-1)); err == nil || c.Flags != Invalid {
+		t.Errorf("Sqrt(-1) = %v, %q", err, c.Flags)
+	}
+}
+
+func TestExactPow(t *testing.T) {
+	tests := []struct {
+		b, n, r Number
+		want    bool
+	}{
+		{Float(81), Float(0.75), Float(27), true},
+		{Float(81), Float(0.75), Number{27, -0x1p-128}, false},
+		{Float(81), Float(-0.75), Div(Float(1), Float(27)), false},
+		{Float(256), Float(-0.75), Float(1.0 / 64), true},
+		{Float(0x1p-600), Float(1.5), Float(0x1p-900), true},
+		{Float(0x1p-600), Float(0.5 + 0x1p-52), Float(0x1p-300), false},
+		{Float(2), Float(0.5), Sqrt2, false},
+		{Float(-3), Float(3), Float(-27), true},
+		{Float(10), Float(40), Number{1e40, -0x1.0151182a7cp+78}, true},
+		{Float(10), Float(40), Float(1e40), false},
+		{Float(3), Float(70), PowInt(Float(3), 70), false},
+		{Float(3), Float(0x1p60), Inf(+1), false},
+		{Float(1), Float(0x1p-1074), Float(1), true},
+		{Float(1), Float(0x1p1023), Float(1), true},
+		{Float(0.5), Float(0x1p-1), Float(0.5), false},
+	}
+	for _, tt := range tests {
+		if got := exactPow(tt.b, tt.n, tt.r); got != tt.want {
+			t.Errorf("exactPow(%v, %v, %v) = %v, want %v", tt.b, tt.n, tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestContext_Sincospi(t *testing.T) {
+	var c Context
+	sin, cos, err := c.Sincospi(Float(1.5))
+	if err != nil || !same(sin, Float(-1)) || !same(cos, Float(0)) || c.Flags != 0 {
+		t.Errorf("Sincospi(1.5) = %v, %v, %v, %q", sin, cos, err, c.Flags)
+	}
+	sin, cos, err = c.Sincospi(Float(0.1))
+	if want, _ := Sincospi(Float(0.1)); err != nil || !same(sin, want) || c.Flags != Inexact {
+		t.Errorf("Sincospi(0.1) = %v, %v, %v, %q", sin, cos, err, c.Flags)
+	}
+}
+
+func TestCondition_String(t *testing.T) {
+	tests := []struct {
+		cond Condition
+		want string
+	}{
+		{0, ""},
+		{Invalid, "invalid operation"},
+		{Overflow | Inexact, "overflow, inexact"},
+		{Invalid | DivByZero | Overflow | Underflow | Inexact, "invalid operation, division by zero, overflow, underflow, inexact"},
+	}
+	for _, tt := range tests {
+		if got := tt.cond.String(); got != tt.want {
+			t.Errorf("String() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestContext_big(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() Number {
+		switch r.Intn(5) {
+		case 0:
+			return Float(float64(r.Intn(21)-10) / 4)
+		case 1:
+			// Close to underflow.
+			return Make(math.Ldexp(float64(r.Intn(1<<20)), r.Intn(60)-1090), math.Ldexp(float64(r.Intn(1<<10)), r.Intn(60)-1120))
+		case 2:
+			// Few significant bits.
+			return Make(math.Ldexp(float64(r.Intn(1<<20)-1<<19), r.Intn(60)-30), math.Ldexp(float64(r.Intn(1<<10)), r.Intn(60)-120))
+		}
+		return Make(math.Ldexp(r.Float64()-0.5, r.Intn(200)-100), math.Ldexp(r.Float64()-0.5, r.Intn(100)-160))
+	}
+
+	// check that Inexact is raised iff got isn't want.
+	check := func(name string, c *Context, got Number, want *big.Rat, args ...Number) {
+		t.Helper()
+		if IsInf(got, 0) {
+			if c.Flags != Overflow|Inexact {
+				t.Fatalf("%s%#v = %#v, %q", name, args, got, c.Flags)
+			}
+			c.Flags = 0
+			return
+		}
+		exact := got.Rat().Cmp(want) == 0
+		if exact != (c.Flags&Inexact == 0) {
+			t.Fatalf("%s%#v = %#v, %q", name, args, got, c.Flags)
+		}
+		if tiny := math.Abs(got.y) < 0x1p-968; (!exact && tiny) != (c.Flags&Underflow != 0) {
+			t.Fatalf("%s%#v = %#v, %q", name, args, got, c.Flags)
+		}
+		c.Flags = 0
+	}
+
+	var c Context
+	for range 5000 {
+		a, b, d := random(), random(), random()
+		ra, rb, rd := a.Rat(), b.Rat(), d.Rat()
+		var want big.Rat
+
+		got, _ := c.Add(a, b)
+		check("Add", &c, got, want.Add(ra, rb), a, b)
+		got, _ = c.Sub(a, b)
+		check("Sub", &c, got, want.Sub(ra, rb), a, b)
+		got, _ = c.Mul(a, b)
+		check("Mul", &c, got, want.Mul(ra, rb), a, b)
+		got, _ = c.FMA(a, b, d)
+		check("FMA", &c, got, want.Add(want.Mul(ra, rb), rd), a, b, d)
+		got, _ = c.MulExact(a, b)
+		check("MulExact", &c, got, want.Mul(ra, rb), a, b)
+		if b.y != 0 {
+			got, _ = c.Div(a, b)
+			check("Div", &c, got, want.Quo(ra, rb), a, b)
+			got, _ = c.DivExact(a, b)
+			check("DivExact", &c, got, want.Quo(ra, rb), a, b)
+		}
+
+		f, rf := b.y, new(big.Rat).SetFloat64(b.y)
+		got, _ = c.AddFloat(a, f)
+		check("AddFloat", &c, got, want.Add(ra, rf), a, b)
+		got, _ = c.SubFloat(f, a)
+		check("SubFloat", &c, got, want.Sub(rf, ra), b, a)
+		got, _ = c.MulFloat(a, f)
+		check("MulFloat", &c, got, want.Mul(ra, rf), a, b)
+		got, _ = c.FMAFloat(a.y, f, d)
+		check("FMAFloat", &c, got, want.Add(want.Mul(new(big.Rat).SetFloat64(a.y), rf), rd), a, b, d)
+		if got := Sqrt(Abs(a)); got.y != 0 {
+			s := Sqr(got)
+			c.Flags = 0
+			got, _ = c.Sqrt(s)
+			q := got.Rat()
+			exact := q.Mul(q, q).Cmp(s.Rat()) == 0
+			if exact != (c.Flags == 0) {
+				t.Fatalf("Sqrt(%#v) = %#v, %q", s, got, c.Flags)
+			}
+			c.Flags = 0
+		}
+	}
+}
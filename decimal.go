@@ -0,0 +1,375 @@
+package dbldbl
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Multiprecision arithmetic for exact binary to decimal conversion.
+// Everything is fixed size, so formatting doesn't allocate.
+
+const (
+	// The exact decimal expansion of a double-double,
+	// or of a rounding bound for it, has fewer than 1400 significant digits:
+	// it's an integer below 2²¹⁰² times a power of 5 no larger than 5¹⁰⁷⁶.
+	natWords  = 156
+	natBase   = 1e9
+	maxDigits = 9 * natWords
+)
+
+// nat is a natural number in base 10⁹, little-endian.
+type nat struct {
+	w [natWords]uint32
+	n int
+}
+
+func (z *nat) setUint64(x uint64) {
+	z.n = 0
+	for x != 0 {
+		z.w[z.n] = uint32(x % natBase)
+		x /= natBase
+		z.n++
+	}
+}
+
+// mulAdd sets z to z⋅m + a.
+func (z *nat) mulAdd(m, a uint32) {
+	c := uint64(a)
+	for i := range z.n {
+		c += uint64(z.w[i]) * uint64(m)
+		z.w[i] = uint32(c % natBase)
+		c /= natBase
+	}
+	for c != 0 {
+		z.w[z.n] = uint32(c % natBase)
+		c /= natBase
+		z.n++
+	}
+}
+
+// add sets z to z + x.
+func (z *nat) add(x uint64) {
+	for i := 0; x != 0; i++ {
+		if i == z.n {
+			z.w[i] = 0
+			z.n++
+		}
+		x += uint64(z.w[i])
+		z.w[i] = uint32(x % natBase)
+		x /= natBase
+	}
+}
+
+// sub sets z to z - x, for z ≥ x.
+func (z *nat) sub(x uint64) {
+	var borrow uint64
+	for i := 0; x != 0 || borrow != 0; i++ {
+		t := x%natBase + borrow
+		x /= natBase
+		if uint64(z.w[i]) >= t {
+			z.w[i] -= uint32(t)
+			borrow = 0
+		} else {
+			z.w[i] += uint32(natBase - t)
+			borrow = 1
+		}
+	}
+	for z.n > 0 && z.w[z.n-1] == 0 {
+		z.n--
+	}
+}
+
+// shl sets z to z⋅2ᵏ.
+func (z *nat) shl(k int) {
+	for ; k >= 31; k -= 31 {
+		z.mulAdd(1<<31, 0)
+	}
+	if k > 0 {
+		z.mulAdd(1<<k, 0)
+	}
+}
+
+// mulPow5 sets z to z⋅5ᵏ.
+func (z *nat) mulPow5(k int) {
+	const pow5_13 = 1220703125
+	for ; k >= 13; k -= 13 {
+		z.mulAdd(pow5_13, 0)
+	}
+	if k > 0 {
+		p := uint32(1)
+		for range k {
+			p *= 5
+		}
+		z.mulAdd(p, 0)
+	}
+}
+
+// setNumber sets z to the integer m such that n = m⋅2ᵉ,
+// where n is canonical and positive, and e is small enough.
+func (z *nat) setNumber(n Number, e int) {
+	mh, eh := split(n.y)
+	z.setUint64(mh)
+	z.shl(eh - e)
+	if n.x != 0 {
+		ml, el := split(n.x)
+		ml <<= el - e
+		if n.x > 0 {
+			z.add(ml)
+		} else {
+			z.sub(ml)
+		}
+	}
+}
+
+// split returns the integer m and exponent e such that |f| = m⋅2ᵉ,
+// with m < 2⁵³ and e ≥ -1074.
+func split(f float64) (m uint64, e int) {
+	b := math.Float64bits(f)
+	e = int(b>>52) & 0x7ff
+	m = b & (1<<52 - 1)
+	if e == 0 {
+		e++
+	} else {
+		m |= 1 << 52
+	}
+	return m, e - 1075
+}
+
+// decimal is a multiprecision decimal number.
+type decimal struct {
+	d  [maxDigits]byte // digits, big-endian representation
+	nd int             // number of digits used
+	dp int             // decimal point
+}
+
+// set sets d to the exact value of z⋅2ᵉ, clobbering z.
+func (d *decimal) set(z *nat, e int) {
+	if e > 0 {
+		z.shl(e)
+	} else {
+		z.mulPow5(-e) // z⋅2ᵉ = z⋅5⁻ᵉ⋅10ᵉ
+	}
+
+	d.nd = 0
+	if z.n == 0 {
+		d.dp = 0
+		return
+	}
+
+	var buf [9]byte
+	for i := z.n - 1; i >= 0; i-- {
+		w := z.w[i]
+		for j := len(buf) - 1; j >= 0; j-- {
+			buf[j] = byte(w%10) + '0'
+			w /= 10
+		}
+		s := buf[:]
+		if i == z.n-1 {
+			for s[0] == '0' {
+				s = s[1:]
+			}
+		}
+		d.nd += copy(d.d[d.nd:], s)
+	}
+
+	d.dp = d.nd
+	if e < 0 {
+		d.dp += e
+	}
+	d.trim()
+}
+
+// trim trailing zeros from d.
+func (d *decimal) trim() {
+	for d.nd > 0 && d.d[d.nd-1] == '0' {
+		d.nd--
+	}
+	if d.nd == 0 {
+		d.dp = 0
+	}
+}
+
+// Round d to nd digits (or fewer), to nearest even.
+// If nd is zero, it means we're rounding
+// just to the left of the digits, as in
+// 0.09 -> 0.1.
+func (d *decimal) Round(nd int) {
+	if nd < 0 || nd >= d.nd {
+		return
+	}
+	if d.d[nd] > '5' || d.d[nd] == '5' &&
+		(nd+1 < d.nd || nd > 0 && (d.d[nd-1]-'0')%2 != 0) {
+		d.RoundUp(nd)
+	} else {
+		d.RoundDown(nd)
+	}
+}
+
+// RoundDown d to nd digits (or fewer).
+func (d *decimal) RoundDown(nd int) {
+	if nd < 0 || nd >= d.nd {
+		return
+	}
+	d.nd = nd
+	d.trim()
+}
+
+// RoundUp d to nd digits (or fewer).
+func (d *decimal) RoundUp(nd int) {
+	if nd < 0 || nd >= d.nd {
+		return
+	}
+	for i := nd - 1; i >= 0; i-- {
+		if d.d[i] < '9' {
+			d.d[i]++
+			d.nd = i + 1
+			return
+		}
+	}
+	// Number is all 9s.
+	d.d[0] = '1'
+	d.nd = 1
+	d.dp++
+}
+
+// shortest rounds d to the shortest decimal between lower and upper.
+func (d *decimal) shortest(lower, upper *decimal, lowerIncl, upperIncl bool) {
+	// As we walk the digits we want to know whether rounding up would fall
+	// within the upper bound. This is tracked by upperdelta:
+	//
+	// If upperdelta == 0, the digits of d and upper are the same so far.
+	//
+	// If upperdelta == 1, we saw a difference of 1 between d and upper on a
+	// previous digit and subsequently only 9s for d and 0s for upper.
+	// (Thus rounding up may fall outside the bound, if it is exclusive.)
+	//
+	// If upperdelta == 2, then the difference is greater than 1
+	// and we know that rounding up falls within the bound.
+	var upperdelta uint8
+
+	// Walk along until d has distinguished itself from upper and lower.
+	// The decimal points may be at different places,
+	// but upper is the longest, so we iterate over it.
+	for ui := 0; ; ui++ {
+		mi := ui - upper.dp + d.dp
+		if mi >= d.nd {
+			return
+		}
+		li := ui - upper.dp + lower.dp
+		l := byte('0') // lower digit
+		if li >= 0 && li < lower.nd {
+			l = lower.d[li]
+		}
+		m := byte('0') // middle digit
+		if mi >= 0 {
+			m = d.d[mi]
+		}
+		u := byte('0') // upper digit
+		if ui < upper.nd {
+			u = upper.d[ui]
+		}
+
+		// Okay to round down (truncate) if lower has a different digit
+		// or if lower is inclusive and is exactly the result of rounding
+		// down (i.e., and we have reached the final digit of lower).
+		okdown := l != m || lowerIncl && li+1 == lower.nd
+
+		switch {
+		case upperdelta == 0 && m+1 < u:
+			upperdelta = 2
+		case upperdelta == 0 && m != u:
+			upperdelta = 1
+		case upperdelta == 1 && (m != '9' || u != '0'):
+			upperdelta = 2
+		}
+		// Okay to round up if upper has a different digit and either upper
+		// is inclusive or upper is bigger than the result of rounding up.
+		okup := upperdelta > 0 && (upperIncl || upperdelta > 1 || ui+1 < upper.nd)
+
+		// If it's okay to do either, then round to the nearest one.
+		// If it's okay to do only one, do it.
+		switch {
+		case okdown && okup:
+			d.Round(mi + 1)
+			return
+		case okdown:
+			d.RoundDown(mi + 1)
+			return
+		case okup:
+			d.RoundUp(mi + 1)
+			return
+		}
+	}
+}
+
+// setShortest sets d to the shortest decimal that parses to n,
+// where n is canonical and positive.
+func (d *decimal) setShortest(n Number) {
+	var z nat
+
+	if n.x == 0 {
+		// If the last nonzero bit of n is 2⁻³²² or above,
+		// the exact decimal expansion has at most 322 fractional digits,
+		// and any other decimal within 2⁻¹⁰⁷⁵ of it needs more.
+		m, e := split(n.y)
+		if e+bits.TrailingZeros64(m) >= -322 {
+			z.setNumber(n, e)
+			d.set(&z, e)
+			return
+		}
+	}
+
+	// Any decimal in [lower, upper] parses to n.
+	// The bounds are 4⋅m - dl and 4⋅m + du in units of 2ᵉ⁻², where 2ᵉ is
+	// the ulp of the low part, or the smallest denormal if it's zero.
+	e := -1074
+	dl, du := uint64(2), uint64(2)
+	incl := true
+	if n.x != 0 {
+		var m uint64
+		m, e = split(n.x)
+		incl = m%2 == 0
+
+		// Below a power of two, the ulp of the low part halves.
+		pow2 := m == 1<<52 && e > -1074
+		// Above half an ulp of the high part, the high part changes,
+		// and the low part flips sign, so its ulp also halves.
+		var half float64
+		if n.x > 0 {
+			half = (math.Nextafter(n.y, math.Inf(+1)) - n.y) / 2
+		} else {
+			half = (n.y - math.Nextafter(n.y, 0)) / 2
+		}
+
+		if n.x > 0 {
+			if pow2 {
+				dl = 1
+			}
+			if n.x == half {
+				du = 1
+			}
+		} else {
+			if pow2 {
+				du = 1
+			}
+			if n.x == -half {
+				dl = 1
+			}
+		}
+	} else if m, e := split(n.y); e == -1074 {
+		// The high part is denormal, so a tie rounds to even.
+		incl = m%2 == 0
+	}
+
+	var lower, upper decimal
+	z.setNumber(n, e)
+	lz, uz := z, z
+	lz.mulAdd(4, 0)
+	lz.sub(dl)
+	uz.mulAdd(4, 0)
+	uz.add(du)
+	lower.set(&lz, e-2)
+	upper.set(&uz, e-2)
+	d.set(&z, e)
+	d.shortest(&lower, &upper, incl, incl)
+}
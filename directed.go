@@ -0,0 +1,358 @@
+package dbldbl
+
+import "math"
+
+// The directed rounding variants compute the same result s as the
+// round-to-nearest operation, then the exact residual of that result,
+// as a sum of floats, using error-free transformations:
+//
+//	a + b - s, a⋅b - s, a - s⋅b, n - s².
+//
+// The sign of the residual says on which side of the exact result s lies.
+// If s is on the wrong side, it's moved by a power of two no smaller than
+// a bound on its distance to the exact result, with the low part rounded
+// in the same direction, and renormalized with twoSum, which is exact.
+//
+// So the result always bounds the exact result, and its distance to it
+// is at most about twice that of the round-to-nearest operation,
+// or a few multiples of 2⁻¹⁰⁷⁴ close to underflow,
+// where the residual may not be exact.
+
+// AddDown returns a lower bound of the sum of a and b.
+func AddDown(a, b Number) Number {
+	return add(a, b, -1)
+}
+
+// AddUp returns an upper bound of the sum of a and b.
+func AddUp(a, b Number) Number {
+	return add(a, b, +1)
+}
+
+// AddFloatDown returns a lower bound of the sum of a and b.
+func AddFloatDown(a Number, b float64) Number {
+	return add(a, Float(b), -1)
+}
+
+// AddFloatUp returns an upper bound of the sum of a and b.
+func AddFloatUp(a Number, b float64) Number {
+	return add(a, Float(b), +1)
+}
+
+// SubDown returns a lower bound of the difference of a and b.
+func SubDown(a, b Number) Number {
+	return add(a, Neg(b), -1)
+}
+
+// SubUp returns an upper bound of the difference of a and b.
+func SubUp(a, b Number) Number {
+	return add(a, Neg(b), +1)
+}
+
+// SubFloatDown returns a lower bound of the difference of a and b.
+func SubFloatDown(a float64, b Number) Number {
+	return add(Float(a), Neg(b), -1)
+}
+
+// SubFloatUp returns an upper bound of the difference of a and b.
+func SubFloatUp(a float64, b Number) Number {
+	return add(Float(a), Neg(b), +1)
+}
+
+// MulDown returns a lower bound of the product of a and b.
+func MulDown(a, b Number) Number {
+	return mul(a, b, -1)
+}
+
+// MulUp returns an upper bound of the product of a and b.
+func MulUp(a, b Number) Number {
+	return mul(a, b, +1)
+}
+
+// MulFloatDown returns a lower bound of the product of a and b.
+func MulFloatDown(a Number, b float64) Number {
+	return mul(a, Float(b), -1)
+}
+
+// MulFloatUp returns an upper bound of the product of a and b.
+func MulFloatUp(a Number, b float64) Number {
+	return mul(a, Float(b), +1)
+}
+
+// DivDown returns a lower bound of the quotient of a and b.
+func DivDown(a, b Number) Number {
+	return div(a, b, -1)
+}
+
+// DivUp returns an upper bound of the quotient of a and b.
+func DivUp(a, b Number) Number {
+	return div(a, b, +1)
+}
+
+// SqrtDown returns a lower bound of the square root of n.
+func SqrtDown(n Number) Number {
+	return sqrt(n, -1)
+}
+
+// SqrtUp returns an upper bound of the square root of n.
+func SqrtUp(n Number) Number {
+	return sqrt(n, +1)
+}
+
+func add(a, b Number, dir int) Number {
+	s := Add(a, b)
+	if !isFinite(s.y) {
+		if isFinite(a.y) && isFinite(b.y) {
+			return overflow(s, dir)
+		}
+		return s
+	}
+
+	r := addResidual(a, b, s)
+	return r.nudge(s, 1, dir)
+}
+
+func mul(a, b Number, dir int) Number {
+	s := Mul(a, b)
+	if !isFinite(s.y) {
+		if isFinite(a.y) && isFinite(b.y) {
+			return overflow(s, dir)
+		}
+		return s
+	}
+
+	r := mulResidual(a, b, s)
+	return r.nudge(s, 1, dir)
+}
+
+func div(a, b Number, dir int) Number {
+	s := Div(a, b)
+	if !isFinite(s.y) {
+		if isFinite(a.y) && isFinite(b.y) && b.y != 0 {
+			return overflow(s, dir)
+		}
+		return s
+	}
+	if !isFinite(b.y) {
+		return s
+	}
+	r, d := divResidual(a, b, s)
+	return r.nudge(s, d, dir)
+}
+
+func sqrt(n Number, dir int) Number {
+	s := Sqrt(n)
+	if s.y == 0 || !isFinite(s.y) {
+		return s
+	}
+	r, d := sqrtResidual(n, s)
+	return r.nudge(s, d, dir)
+}
+
+// addResidual returns a + b - s.
+func addResidual(a, b, s Number) (r expansion) {
+	r.add(a.y)
+	r.add(a.x)
+	r.add(b.y)
+	r.add(b.x)
+	r.add(-s.y)
+	r.add(-s.x)
+	return r
+}
+
+// mulResidual returns a⋅b - s.
+func mulResidual(a, b, s Number) (r expansion) {
+	r.mulAdd(a.y, b.y)
+	r.mulAdd(a.y, b.x)
+	r.mulAdd(a.x, b.y)
+	r.mulAdd(a.x, b.x)
+	r.add(-s.y)
+	r.add(-s.x)
+	return r
+}
+
+// divResidual returns r and d, such that a/b - s = r/d,
+// for a nonzero, finite b.
+func divResidual(a, b, s Number) (r expansion, d float64) {
+	// Scale b up, so the products below don't underflow.
+	if _, k := math.Frexp(b.y); k < 0 {
+		a = Ldexp(a, -k)
+		b = Ldexp(b, -k)
+	}
+
+	// a/b - s = (a - s⋅b)/b
+	r.add(a.y)
+	r.add(a.x)
+	r.mulAdd(-s.y, b.y)
+	r.mulAdd(-s.y, b.x)
+	r.mulAdd(-s.x, b.y)
+	r.mulAdd(-s.x, b.x)
+	return r, b.y
+}
+
+// sqrtResidual returns r and d, such that √n - s = r/d,
+// for a nonzero, finite s.
+func sqrtResidual(n, s Number) (r expansion, d float64) {
+	// Scale s up, so the products below don't underflow.
+	t, d := s, s.y
+	if _, k := math.Frexp(s.y); k < 0 {
+		n = Ldexp(n, -2*k)
+		t = Ldexp(s, -k)
+		d = math.Ldexp(s.y, -2*k)
+	}
+
+	// √n - s = (n - s²)/(√n + s)
+	r.add(n.y)
+	r.add(n.x)
+	r.mulAdd(-t.y, t.y)
+	r.mulAdd(-2*t.y, t.x)
+	r.mulAdd(-t.x, t.x)
+	return r, d
+}
+
+// overflow returns the bound in direction dir of a
+// finite result that rounded to the infinity s.
+func overflow(s Number, dir int) Number {
+	// The largest finite Number.
+	max := Number{math.MaxFloat64, 0x1p970 - 0x1p917}
+	switch {
+	case IsInf(s, +1) && dir < 0:
+		return max
+	case IsInf(s, -1) && dir > 0:
+		return Neg(max)
+	}
+	return s
+}
+
+// expansion is an exact sum of floats.
+// Its components don't overlap, and increase in magnitude,
+// so the sign of the sum is the sign of the last one.
+type expansion struct {
+	c   [16]float64
+	n   int
+	err float64 // bound on the error from products that underflowed
+}
+
+// add adds f to e.
+func (e *expansion) add(f float64) {
+	// Shewchuk's Grow-Expansion, eliminating zeros.
+	var m int
+	for _, c := range e.c[:e.n] {
+		s := twoSum(f, c)
+		if s.x != 0 {
+			e.c[m] = s.x
+			m++
+		}
+		f = s.y
+	}
+	if f != 0 {
+		e.c[m] = f
+		m++
+	}
+	e.n = m
+}
+
+// mulAdd adds a⋅b to e.
+func (e *expansion) mulAdd(a, b float64) {
+	p := twoProd(a, b)
+	// The error term is exact unless
+	// the product is close to underflowing.
+	if math.Abs(p.y) < 0x1p-968 && a != 0 && b != 0 {
+		e.err += 0x1p-1074
+	}
+	e.add(p.y)
+	e.add(p.x)
+}
+
+// round returns the sum of e rounded to a Number,
+// and the remainder rounded to a float64.
+func (e *expansion) round() (Number, float64) {
+	var s Number
+	for _, c := range e.c[:e.n] {
+		s = AddFloat(s, c)
+	}
+	e.add(-s.y)
+	e.add(-s.x)
+	var r float64
+	for _, c := range e.c[:e.n] {
+		r += c
+	}
+	return s, r
+}
+
+// isZero reports whether the sum of e is zero.
+// If products that underflowed make that uncertain,
+// it returns the result of exact instead.
+func (e *expansion) isZero(exact func() bool) bool {
+	switch {
+	case e.err == 0:
+		return e.n == 0
+	case e.n > 0 && math.Abs(e.c[e.n-1])*(1-0x1p-48) > e.err:
+		return false
+	}
+	return exact()
+}
+
+// bound returns a bound on the magnitude of the sum of e.
+func (e *expansion) bound() float64 {
+	var top float64
+	if e.n > 0 {
+		top = e.c[e.n-1]
+	}
+	// The components before top add up to less than an ulp of it,
+	// and each rounding loses less than the slack.
+	const slack = 1 + 0x1p-48
+	return math.Abs(top)*slack + e.err
+}
+
+// nudge returns s, if the residual e/d of s
+// is of the opposite sign of dir (or zero),
+// otherwise s moved in direction dir by at least e/d.
+func (e *expansion) nudge(s Number, d float64, dir int) Number {
+	var top float64
+	if e.n > 0 {
+		top = e.c[e.n-1]
+	}
+	if math.Abs(top)*(1-0x1p-48) > e.err {
+		sign := 1
+		if top < 0 != (d < 0) {
+			sign = -1
+		}
+		if sign != dir {
+			return s
+		}
+	} else if top == 0 && e.err == 0 {
+		return s // exact
+	}
+
+	// Below 2⁻¹⁰²¹ the sum with err is exact,
+	// and the quotient loses less than 2⁻¹⁰⁷⁴.
+	const slack = 1 + 0x1p-48
+	bound := e.bound()
+	if d != 1 {
+		bound = bound/math.Abs(d)*slack + 0x1p-1074
+	}
+	if math.IsInf(bound, 0) {
+		return Inf(dir)
+	}
+
+	// Round the bound up to a power of two.
+	delta := math.SmallestNonzeroFloat64
+	if bound > delta {
+		frac, exp := math.Frexp(bound)
+		if frac != 0.5 {
+			exp++
+		}
+		delta = math.Ldexp(1, exp-1)
+	}
+	if dir < 0 {
+		delta = -delta
+	}
+
+	// Move the low part, rounding in direction dir.
+	t := twoSum(s.x, delta)
+	x := t.y
+	if t.x < 0 && dir < 0 || t.x > 0 && dir > 0 {
+		x = math.Nextafter(x, math.Inf(dir))
+	}
+	return AddFloats(s.y, x)
+}
@@ -0,0 +1,120 @@
+package dbldbl
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestDirected(t *testing.T) {
+	max := Number{math.MaxFloat64, 0x1p970 - 0x1p917}
+	third := Div(Float(1), Float(3))
+	tests := []struct {
+		name     string
+		down, up Number
+		want     [2]Number
+	}{
+		{"Add(1, 2)", AddDown(Float(1), Float(2)), AddUp(Float(1), Float(2)), [2]Number{{3, 0}, {3, 0}}},
+		{"Add(1, 2⁻²⁰⁰)", AddDown(Float(1), Float(0x1p-200)), AddUp(Float(1), Float(0x1p-200)), [2]Number{{1, 0x1p-200}, {1, 0x1p-200}}},
+		{"Add(1+2⁻⁶⁰, 2⁻²⁰⁰)", AddFloatDown(Number{1, 0x1p-60}, 0x1p-200), AddFloatUp(Number{1, 0x1p-60}, 0x1p-200), [2]Number{{1, 0x1p-60}, {1, 0x1.0000000000001p-60}}},
+		{"Sub(-1-2⁻⁶⁰, 2⁻²⁰⁰)", SubDown(Number{-1, -0x1p-60}, Float(0x1p-200)), SubUp(Number{-1, -0x1p-60}, Float(0x1p-200)), [2]Number{{-1, -0x1.0000000000001p-60}, {-1, -0x1p-60}}},
+		{"Add(max, max)", AddDown(max, max), AddUp(max, max), [2]Number{max, Inf(+1)}},
+		{"Sub(-max, max)", SubDown(Neg(max), max), SubUp(Neg(max), max), [2]Number{Inf(-1), Neg(max)}},
+		{"Sub(Inf, Inf)", SubDown(Inf(+1), Inf(+1)), SubUp(Inf(+1), Inf(+1)), [2]Number{NaN(), NaN()}},
+		{"Mul(3, 1/3)", MulDown(Float(3), third), MulUp(Float(3), third), [2]Number{{1, -0x1p-108}, {1, -0x1p-108}}},
+		// The sign of the residual is lost to underflow.
+		{"Mul(2⁻⁶⁰⁰, 2⁻⁶⁰⁰)", MulDown(Float(0x1p-600), Float(0x1p-600)), MulUp(Float(0x1p-600), Float(0x1p-600)), [2]Number{{-0x1p-1074, 0}, {0x1p-1074, 0}}},
+		{"Mul(-2⁻⁶⁰⁰, 2⁻⁶⁰⁰)", MulDown(Float(-0x1p-600), Float(0x1p-600)), MulUp(Float(-0x1p-600), Float(0x1p-600)), [2]Number{{-0x1p-1074, 0}, {0x1p-1074, 0}}},
+		{"Mul(max, 2)", MulFloatDown(max, 2), MulFloatUp(max, 2), [2]Number{max, Inf(+1)}},
+		{"Div(1, 3)", DivDown(Float(1), Float(3)), DivUp(Float(1), Float(3)), [2]Number{third, {third.y, math.Nextafter(third.x, 1)}}},
+		{"Div(6, 3)", DivDown(Float(6), Float(3)), DivUp(Float(6), Float(3)), [2]Number{{2, 0}, {2, 0}}},
+		{"Div(1, 0)", DivDown(Float(1), Float(0)), DivUp(Float(1), Float(0)), [2]Number{Inf(+1), Inf(+1)}},
+		{"Div(1, Inf)", DivDown(Float(1), Inf(+1)), DivUp(Float(1), Inf(+1)), [2]Number{{}, {}}},
+		{"Sqrt(4)", SqrtDown(Float(4)), SqrtUp(Float(4)), [2]Number{{2, 0}, {2, 0}}},
+		{"Sqrt(-0)", SqrtDown(Float(-zero)), SqrtUp(Float(-zero)), [2]Number{{-zero, 0}, {-zero, 0}}},
+		{"Sqrt(Inf)", SqrtDown(Inf(+1)), SqrtUp(Inf(+1)), [2]Number{Inf(+1), Inf(+1)}},
+		{"Sqrt(-1)", SqrtDown(Float(-1)), SqrtUp(Float(-1)), [2]Number{NaN(), NaN()}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !same(tt.down, tt.want[0]) {
+				t.Errorf("Down = %#v, want %#v", tt.down, tt.want[0])
+			}
+			if !same(tt.up, tt.want[1]) {
+				t.Errorf("Up = %#v, want %#v", tt.up, tt.want[1])
+			}
+		})
+	}
+}
+
+func TestDirected_big(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func() Number {
+		switch r.Intn(5) {
+		case 0:
+			return Float(float64(r.Intn(21)-10) / 4)
+		case 1:
+			// Close to underflow.
+			return Make(math.Ldexp(r.Float64()-0.5, r.Intn(60)-1000), math.Ldexp(r.Float64()-0.5, r.Intn(60)-1070))
+		}
+		return Make(math.Ldexp(r.Float64()-0.5, r.Intn(200)-100), math.Ldexp(r.Float64()-0.5, r.Intn(100)-160))
+	}
+
+	// check that down ≤ want ≤ up, that they're canonical,
+	// and that they're about as close to want as the nearest result s.
+	check := func(name string, want *big.Rat, s, down, up Number, args ...Number) {
+		t.Helper()
+		if !IsCanonical(down) || !IsCanonical(up) {
+			t.Fatalf("%s%#v = %#v, %#v", name, args, down, up)
+		}
+		lo, hi := down.Rat(), up.Rat()
+		if lo == nil && !IsInf(down, -1) || lo != nil && lo.Cmp(want) > 0 ||
+			hi == nil && !IsInf(up, +1) || hi != nil && hi.Cmp(want) < 0 {
+			t.Fatalf("%s%#v = %#v, %#v, want %v", name, args, down, up, want.FloatString(40))
+		}
+		if lo == nil || hi == nil {
+			return // overflow
+		}
+		// up - down ≤ 3⋅|s - want| + 2⁻¹⁰⁰⋅|want| + 2⁻¹⁰⁶⁴
+		var d, b, e big.Rat
+		d.Sub(hi, lo)
+		b.Abs(want).Mul(&b, new(big.Rat).SetFloat64(0x1p-100))
+		b.Add(&b, new(big.Rat).SetFloat64(0x1p-1064))
+		e.Sub(s.Rat(), want).Abs(&e).Mul(&e, big.NewRat(3, 1))
+		b.Add(&b, &e)
+		if d.Cmp(&b) > 0 {
+			t.Fatalf("%s%#v = %#v, %#v, not tight", name, args, down, up)
+		}
+	}
+
+	for range 2000 {
+		a, b := random(), random()
+		ra, rb := a.Rat(), b.Rat()
+		var want big.Rat
+
+		check("Add", want.Add(ra, rb), Add(a, b), AddDown(a, b), AddUp(a, b), a, b)
+		check("Sub", want.Sub(ra, rb), Sub(a, b), SubDown(a, b), SubUp(a, b), a, b)
+		check("Mul", want.Mul(ra, rb), Mul(a, b), MulDown(a, b), MulUp(a, b), a, b)
+		check("AddFloat", want.Add(ra, new(big.Rat).SetFloat64(b.y)), AddFloat(a, b.y), AddFloatDown(a, b.y), AddFloatUp(a, b.y), a, b)
+		check("SubFloat", want.Sub(new(big.Rat).SetFloat64(a.y), rb), SubFloat(a.y, b), SubFloatDown(a.y, b), SubFloatUp(a.y, b), a, b)
+		check("MulFloat", want.Mul(ra, new(big.Rat).SetFloat64(b.y)), MulFloat(a, b.y), MulFloatDown(a, b.y), MulFloatUp(a, b.y), a, b)
+		if b.y != 0 {
+			check("Div", want.Quo(ra, rb), Div(a, b), DivDown(a, b), DivUp(a, b), a, b)
+		}
+
+		// down² ≤ a ≤ up²
+		a = Abs(a)
+		down, up := SqrtDown(a), SqrtUp(a)
+		if !IsCanonical(down) || !IsCanonical(up) {
+			t.Fatalf("Sqrt(%#v) = %#v, %#v", a, down, up)
+		}
+		lo, hi := down.Rat(), up.Rat()
+		if lo.Mul(lo, lo).Cmp(a.Rat()) > 0 || hi.Mul(hi, hi).Cmp(a.Rat()) < 0 {
+			t.Fatalf("Sqrt(%#v) = %#v, %#v", a, down, up)
+		}
+		if s := Sqrt(a); a.y > 0x1p-900 && Cmp(Sub(up, down), Ldexp(s, -100)) > 0 {
+			t.Fatalf("Sqrt(%#v) = %#v, %#v, not tight", a, down, up)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package dbldbl
+
+import (
+	"math"
+	"math/big"
+)
+
+// The exactly rounded variants compute the exact result with math/big,
+// and round it like Parse does: the high part is the float64 nearest
+// to the result, and the low part is the float64 nearest to the remainder.
+// They're much slower than their approximate counterparts.
+
+// MulExact returns the product of a and b (exactly rounded).
+func MulExact(a, b Number) Number {
+	if a.y == 0 || b.y == 0 || !isFinite(a.y) || !isFinite(b.y) {
+		return Number{y: a.y * b.y}
+	}
+	var r big.Rat
+	return FromRat(r.Mul(a.Rat(), b.Rat()))
+}
+
+// DivExact returns the quotient of a and b (exactly rounded).
+func DivExact(a, b Number) Number {
+	if a.y == 0 || b.y == 0 || !isFinite(a.y) || !isFinite(b.y) {
+		return Number{y: a.y / b.y}
+	}
+	var r big.Rat
+	return FromRat(r.Quo(a.Rat(), b.Rat()))
+}
+
+// SqrtExact returns the square root of n (exactly rounded).
+func SqrtExact(n Number) Number {
+	if n.y <= 0 || !isFinite(n.y) {
+		return Sqrt(n)
+	}
+
+	// n = m⋅2ᵉ, with m an integer, and e even and at most -2152,
+	// so the square root of 2ᵉ is no larger than 2⁻¹⁰⁷⁶.
+	e := min(quantum(n), -2152)
+	e -= e & 1
+
+	var m, t big.Int
+	setScaled(&m, n, e)
+
+	// √n = (s + f)⋅2ᵉᐟ², with s = ⌊√m⌋ and 0 ≤ f < 1.
+	// Every rounding midpoint, of the result or of the remainder,
+	// is a multiple of 2⁻¹⁰⁷⁵, and so of 2ᵉᐟ²: replacing
+	// a nonzero f with ½ doesn't change how either rounds.
+	var s big.Int
+	s.Sqrt(&m)
+	exact := t.Mul(&s, &s).Cmp(&m) == 0
+	s.Lsh(&s, 1)
+	if !exact {
+		s.SetBit(&s, 0, 1)
+	}
+
+	// (s + ½)⋅2ᵉᐟ² = (2⋅s + 1)/2¹⁻ᵉᐟ²
+	var r big.Rat
+	t.SetInt64(1).Lsh(&t, uint(1-e/2))
+	return FromRat(r.SetFrac(&s, &t))
+}
+
+// Mod returns the floating-point remainder of a/b (exactly rounded).
+// The result has the sign of a, and a magnitude less than that of b.
+// It's exact whenever the remainder is representable,
+// which includes the cases where a and b are float64s.
+//
+// Special cases are:
+//
+//	Mod(±Inf, b) = NaN
+//	Mod(NaN, b) = NaN
+//	Mod(a, 0) = NaN
+//	Mod(a, ±Inf) = a
+//	Mod(a, NaN) = NaN
+func Mod(a, b Number) Number {
+	switch {
+	case b.y == 0 || !isFinite(a.y) || IsNaN(b):
+		return NaN()
+	case IsInf(b, 0) || Cmp(Abs(a), Abs(b)) < 0:
+		return a
+	case a.x == 0 && b.x == 0:
+		return Float(math.Mod(a.y, b.y))
+	}
+	return rem(a, b, false)
+}
+
+// Remainder returns the IEEE 754 floating-point remainder of a/b (exactly rounded).
+// The result is a - n⋅b, where n is the integer nearest to a/b,
+// rounding half to even.
+// It's exact whenever the remainder is representable,
+// which includes the cases where a and b are float64s.
+//
+// Special cases are:
+//
+//	Remainder(±Inf, b) = NaN
+//	Remainder(NaN, b) = NaN
+//	Remainder(a, 0) = NaN
+//	Remainder(a, ±Inf) = a
+//	Remainder(a, NaN) = NaN
+func Remainder(a, b Number) Number {
+	switch {
+	case b.y == 0 || !isFinite(a.y) || IsNaN(b):
+		return NaN()
+	case IsInf(b, 0):
+		return a
+	case a.x == 0 && b.x == 0:
+		return Float(math.Remainder(a.y, b.y))
+	}
+	return rem(a, b, true)
+}
+
+// rem returns the remainder of the division of a by b,
+// with the quotient rounded to zero, or to nearest even.
+func rem(a, b Number, even bool) Number {
+	// a = m⋅2ᵉ and b = d⋅2ᵉ, with m and d integers.
+	e := min(quantum(a), quantum(b))
+	var m, d, q big.Int
+	setScaled(&m, a, e)
+	setScaled(&d, b, e)
+	q.QuoRem(&m, &d, &m)
+
+	if even {
+		// Round the quotient away from zero if |m| > |d|/2,
+		// or |m| = |d|/2 and the quotient is odd.
+		var t big.Int
+		c := t.Lsh(&m, 1).CmpAbs(&d)
+		if c > 0 || c == 0 && q.Bit(0) != 0 {
+			if m.Sign() == d.Sign() {
+				m.Sub(&m, &d)
+			} else {
+				m.Add(&m, &d)
+			}
+		}
+	}
+
+	if m.Sign() == 0 {
+		return Number{y: math.Copysign(0, a.y)}
+	}
+	var r big.Rat
+	if e < 0 {
+		d.SetInt64(1).Lsh(&d, uint(-e))
+		r.SetFrac(&m, &d)
+	} else {
+		r.SetInt(m.Lsh(&m, uint(e)))
+	}
+	return FromRat(&r)
+}
+
+// quantum returns the exponent of the least significant bit of n,
+// which must be finite.
+func quantum(n Number) int {
+	_, e := split(n.y)
+	if n.x != 0 {
+		_, el := split(n.x)
+		e = min(e, el)
+	}
+	return e
+}
+
+// setScaled sets z to n⋅2⁻ᵉ, which must be an integer, and returns z.
+func setScaled(z *big.Int, n Number, e int) *big.Int {
+	var t big.Int
+	m, eh := split(n.y)
+	z.SetUint64(m).Lsh(z, uint(eh-e))
+	if n.y < 0 {
+		z.Neg(z)
+	}
+	if n.x != 0 {
+		m, el := split(n.x)
+		t.SetUint64(m).Lsh(&t, uint(el-e))
+		if n.x < 0 {
+			z.Sub(z, &t)
+		} else {
+			z.Add(z, &t)
+		}
+	}
+	return z
+}
@@ -0,0 +1,155 @@
+package dbldbl
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestExact(t *testing.T) {
+	tenth, _ := Parse("0.1")
+	tests := []struct {
+		name string
+		got  Number
+		want Number
+	}{
+		{"MulExact(2, 3)", MulExact(Float(2), Float(3)), Float(6)},
+		{"MulExact(-1, 0)", MulExact(Float(-1), Float(0)), Float(-zero)},
+		{"MulExact(Inf, 0)", MulExact(Inf(1), Float(0)), NaN()},
+		{"MulExact(Max, 2)", MulExact(Float(math.MaxFloat64), Float(2)), Inf(+1)},
+		{"MulExact(2⁻⁶⁰⁰, 2⁻⁶⁰⁰)", MulExact(Float(0x1p-600), Float(0x1p-600)), Float(0)},
+		{"MulExact(1+2⁻⁵², 1+2⁻⁵²)", MulExact(Float(1+0x1p-52), Float(1+0x1p-52)), Number{1 + 0x1p-51, 0x1p-104}},
+		// Mul and Sqrt are off by an ulp of the low part.
+		{"MulExact", MulExact(Number{1.8038448294975284, +0x1.4171164d96aap-59}, Number{1.9793855668801459, +0x1.8c48901d52b88p-57}), Number{3.5705044203987857, -0x1.f1ce64b653733p-53}},
+		{"SqrtExact", SqrtExact(Number{1.5443642101331307, -0x1.7a8aca1c68f93p-55}), Number{1.2427245109569258, +0x1.df21ae669e2cdp-54}},
+		{"DivExact(1, 10)", DivExact(Float(1), Float(10)), tenth},
+		{"DivExact(-1, 0)", DivExact(Float(-1), Float(0)), Inf(-1)},
+		{"DivExact(0, 0)", DivExact(Float(0), Float(0)), NaN()},
+		{"DivExact(1, Inf)", DivExact(Float(1), Inf(1)), Float(0)},
+		{"SqrtExact(4)", SqrtExact(Float(4)), Float(2)},
+		{"SqrtExact(2)", SqrtExact(Float(2)), Sqrt2},
+		{"SqrtExact(2⁻¹⁰⁷⁴)", SqrtExact(Float(0x1p-1074)), Float(0x1p-537)},
+		{"SqrtExact(Max)", SqrtExact(Float(math.MaxFloat64)), Sqrt(Float(math.MaxFloat64))},
+		{"SqrtExact(-0)", SqrtExact(Float(-zero)), Float(-zero)},
+		{"SqrtExact(-1)", SqrtExact(Float(-1)), NaN()},
+		{"SqrtExact(Inf)", SqrtExact(Inf(1)), Inf(1)},
+		{"Mod(7, 3)", Mod(Float(7), Float(3)), Float(1)},
+		{"Mod(-7, 3)", Mod(Float(-7), Float(3)), Float(-1)},
+		{"Mod(-6, 3)", Mod(Float(-6), Float(3)), Float(-zero)},
+		{"Mod(2¹⁰⁰+1, 3)", Mod(Number{0x1p100, 1}, Float(3)), Float(2)},
+		{"Mod(2¹⁰⁰-2⁻¹⁰⁰, 3)", Mod(Number{0x1p100, -0x1p-100}, Float(3)), Number{1, -0x1p-100}},
+		{"Mod(-2¹⁰⁰, 1+2⁻¹⁰⁰)", Mod(Float(-0x1p100), Number{1, 0x1p-100}), Float(-0x1p-100)},
+		{"Mod(2¹⁰⁰, 3+2⁻¹⁰⁰)", Mod(Float(0x1p100), Number{3, 0x1p-100}), Number{0.6666666666666666, 0x1.555555555558p-55}},
+		{"Mod(1, 3)", Mod(Float(1), Float(3)), Float(1)},
+		{"Mod(Inf, 3)", Mod(Inf(1), Float(3)), NaN()},
+		{"Mod(1, 0)", Mod(Float(1), Float(0)), NaN()},
+		{"Mod(1, Inf)", Mod(Float(1), Inf(-1)), Float(1)},
+		{"Mod(1, NaN)", Mod(Float(1), NaN()), NaN()},
+		{"Remainder(7, 3)", Remainder(Float(7), Float(3)), Float(1)},
+		{"Remainder(8, 3)", Remainder(Float(8), Float(3)), Float(-1)},
+		{"Remainder(5, 2)", Remainder(Float(5), Float(2)), Float(1)},
+		{"Remainder(7, 2)", Remainder(Float(7), Float(2)), Float(-1)},
+		{"Remainder(-6, 3)", Remainder(Float(-6), Float(3)), Float(-zero)},
+		{"Remainder(2¹⁰⁰+1, 2)", Remainder(Number{0x1p100, 1}, Float(2)), Float(1)},
+		{"Remainder(2¹⁰⁰+3, 2)", Remainder(Number{0x1p100, 3}, Float(2)), Float(-1)},
+		{"Remainder(2¹⁰⁰-2⁻¹⁰⁰, 3)", Remainder(Number{0x1p100, -0x1p-100}, Float(3)), Number{1, -0x1p-100}},
+		{"Remainder(5+2⁻¹⁰⁰, 2)", Remainder(Number{5, 0x1p-100}, Float(2)), Number{-1, 0x1p-100}},
+		{"Remainder(Inf, 3)", Remainder(Inf(1), Float(3)), NaN()},
+		{"Remainder(1, 0)", Remainder(Float(1), Float(0)), NaN()},
+		{"Remainder(1, Inf)", Remainder(Float(1), Inf(-1)), Float(1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !same(tt.got, tt.want) {
+				t.Errorf("got %#v, want %#v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExact_big(t *testing.T) {
+	const prec = 4000
+
+	// nearest returns the Number nearest to b.
+	nearest := func(b *big.Float) Number {
+		y, _ := b.Float64()
+		if y == 0 || !isFinite(y) {
+			return Float(y)
+		}
+		var t big.Float
+		t.SetPrec(prec).Sub(b, big.NewFloat(y))
+		x, _ := t.Float64()
+		return Make(y, x)
+	}
+
+	r := rand.New(rand.NewSource(1))
+	random := func() Number {
+		switch r.Intn(4) {
+		case 0:
+			return Float(float64(r.Intn(21)-10) / 4)
+		case 1:
+			// Gaps between the high and low parts.
+			return Make(math.Ldexp(r.Float64(), r.Intn(40)-20), math.Ldexp(r.Float64()-0.5, -r.Intn(1000)-60))
+		case 2:
+			// Close to underflow.
+			return Make(math.Ldexp(r.Float64()-0.5, r.Intn(60)-1000), math.Ldexp(r.Float64()-0.5, r.Intn(60)-1070))
+		}
+		return Make(math.Ldexp(r.Float64()-0.5, r.Intn(200)-100), math.Ldexp(r.Float64()-0.5, r.Intn(100)-160))
+	}
+
+	for range 2000 {
+		a, b := random(), random()
+		var z big.Float
+		z.SetPrec(prec)
+
+		want := nearest(z.Mul(a.BigFloat(nil), b.BigFloat(nil)))
+		if got := MulExact(a, b); !same(got, want) {
+			t.Fatalf("MulExact(%#v, %#v) = %#v, want %#v", a, b, got, want)
+		}
+		if b.y != 0 {
+			want := nearest(z.Quo(a.BigFloat(nil), b.BigFloat(nil)))
+			if got := DivExact(a, b); !same(got, want) {
+				t.Fatalf("DivExact(%#v, %#v) = %#v, want %#v", a, b, got, want)
+			}
+		}
+		if b.y != 0 {
+			// a - n⋅b, for the quotient n rounded to zero, and to even.
+			var q big.Int
+			z.Quo(a.BigFloat(nil), b.BigFloat(nil)).Int(&q)
+			mod := func(q *big.Int) Number {
+				var t big.Float
+				t.SetPrec(prec).SetInt(q)
+				want := nearest(z.Sub(a.BigFloat(nil), t.Mul(&t, b.BigFloat(nil))))
+				if want.y == 0 {
+					want.y = math.Copysign(0, a.y)
+				}
+				return want
+			}
+
+			want := mod(&q)
+			if got := Mod(a, b); !same(got, want) {
+				t.Fatalf("Mod(%#v, %#v) = %#v, want %#v", a, b, got, want)
+			}
+			z.Quo(a.BigFloat(nil), b.BigFloat(nil))
+			z.Sub(&z, new(big.Float).SetInt(&q)).Abs(&z)
+			if c := z.Cmp(big.NewFloat(0.5)); c > 0 || c == 0 && q.Bit(0) != 0 {
+				if a.y < 0 != (b.y < 0) {
+					q.Sub(&q, big.NewInt(1))
+				} else {
+					q.Add(&q, big.NewInt(1))
+				}
+			}
+			want = mod(&q)
+			if got := Remainder(a, b); !same(got, want) {
+				t.Fatalf("Remainder(%#v, %#v) = %#v, want %#v", a, b, got, want)
+			}
+		}
+		if a = Abs(a); a.y != 0 {
+			want := nearest(z.Sqrt(a.BigFloat(nil)))
+			if got := SqrtExact(a); !same(got, want) {
+				t.Fatalf("SqrtExact(%#v) = %#v, want %#v", a, got, want)
+			}
+		}
+	}
+}
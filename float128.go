@@ -0,0 +1,96 @@
+package dbldbl
+
+import (
+	"encoding/binary"
+	"math"
+	"math/big"
+)
+
+// IEEE 754 binary128 layout.
+const (
+	f128Bias     = 16383
+	f128MantBits = 112
+	f128ExpMask  = 0x7fff
+	f128HiMant   = 1<<(f128MantBits-64) - 1
+)
+
+// FromFloat128Bits returns the Number nearest to the
+// IEEE 754 binary128 value with the given bits:
+// hi holds the sign, the exponent, and the top 48 bits of the significand;
+// lo holds the bottom 64 bits of the significand.
+//
+// Values too large to be represented become ±Inf,
+// and values too small to be represented become ±0.
+// The top 51 bits of a NaN payload are kept, and the NaN is made quiet.
+func FromFloat128Bits(hi, lo uint64) Number {
+	neg := hi>>63 != 0
+	exp := int(hi>>(f128MantBits-64)) & f128ExpMask
+	mant := hi & f128HiMant
+
+	var sign uint64
+	if neg {
+		sign = 1 << 63
+	}
+	switch {
+	case exp == f128ExpMask && mant|lo != 0:
+		// Top bits of the payload, and the quiet bit.
+		payload := mant<<4 | lo>>60 | 1<<51
+		return Number{y: math.Float64frombits(sign | 0x7ff<<52 | payload)}
+	case exp == f128ExpMask:
+		return Number{y: math.Float64frombits(sign | 0x7ff<<52)}
+	case exp == 0:
+		// Subnormals are much too small.
+		return Number{y: math.Float64frombits(sign)}
+	}
+
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], mant|1<<(f128MantBits-64))
+	binary.BigEndian.PutUint64(buf[8:], lo)
+	var m big.Int
+	m.SetBytes(buf[:])
+
+	var b big.Float
+	b.SetInt(&m)
+	b.SetMantExp(&b, exp-f128Bias-f128MantBits)
+	if neg {
+		b.Neg(&b)
+	}
+	n, _ := FromBigFloat(&b)
+	return n
+}
+
+// Float128Bits returns the bits of the IEEE 754 binary128 value nearest to n,
+// in the layout accepted by [FromFloat128Bits].
+//
+// The significand of n can have more than the 113 bits of a binary128,
+// in which case it's rounded to nearest even.
+// The payload of a NaN is kept in the top bits of the significand.
+func (n Number) Float128Bits() (hi, lo uint64) {
+	b := math.Float64bits(n.y)
+	sign := b & (1 << 63)
+	switch {
+	case IsNaN(n):
+		payload := b & (1<<52 - 1)
+		return sign | f128ExpMask<<(f128MantBits-64) | payload>>4, payload << 60
+	case IsInf(n, 0):
+		return sign | f128ExpMask<<(f128MantBits-64), 0
+	case n.y == 0:
+		return sign, 0
+	}
+
+	var t big.Float
+	n.BigFloat(&t)
+	t.SetMode(big.ToNearestEven).SetPrec(f128MantBits + 1)
+
+	// t = m⋅2ᵉ, with m an integer with 113 bits.
+	e := t.MantExp(nil) - 1 - f128MantBits
+	m, _ := t.SetMantExp(&t, -e).Int(nil)
+	m.Abs(m)
+
+	var buf [16]byte
+	m.FillBytes(buf[:])
+	hi = binary.BigEndian.Uint64(buf[:8]) & f128HiMant
+	lo = binary.BigEndian.Uint64(buf[8:])
+	exp := uint64(e + f128Bias + f128MantBits)
+	return sign | exp<<(f128MantBits-64) | hi, lo
+}
@@ -0,0 +1,108 @@
+package dbldbl
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestNumber_Float128Bits(t *testing.T) {
+	tests := []struct {
+		arg    Number
+		hi, lo uint64
+	}{
+		{Number{}, 0, 0},
+		{Number{-zero, 0}, 0x8000000000000000, 0},
+		{Number{1, 0}, 0x3fff000000000000, 0},
+		{Number{-2, 0}, 0xc000000000000000, 0},
+		{Number{1, 0x1p-112}, 0x3fff000000000000, 1},
+		{Number{1, 0x1p-113}, 0x3fff000000000000, 0},
+		{Number{1, 0x3p-114}, 0x3fff000000000000, 1},
+		{Number{1, 0x1p-200}, 0x3fff000000000000, 0},
+		{Number{1, -0x1p-113}, 0x3ffeffffffffffff, 0xffffffffffffffff},
+		{Number{1, -0x1p-200}, 0x3fff000000000000, 0},
+		{Number{1 + 0x1p-52, -0x1p-114}, 0x3fff000000000000, 0x1000000000000000},
+		{Pi, 0x4000921fb54442d1, 0x8469898cc51701c0},
+		{Number{math.SmallestNonzeroFloat64, 0}, 0x3bcd000000000000, 0},
+		{Number{math.MaxFloat64, 0x1.ffffffcp969}, 0x43feffffffffffff, 0xf7ffffff00000000},
+		{Inf(+1), 0x7fff000000000000, 0},
+		{Inf(-1), 0xffff000000000000, 0},
+		{NaN(), 0x7fff800000000000, 0x1000000000000000},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%016x%016x", tt.hi, tt.lo), func(t *testing.T) {
+			hi, lo := tt.arg.Float128Bits()
+			if hi != tt.hi || lo != tt.lo {
+				t.Errorf("Float128Bits() = %016x%016x, want %016x%016x", hi, lo, tt.hi, tt.lo)
+			}
+		})
+	}
+}
+
+func TestFromFloat128Bits(t *testing.T) {
+	tests := []struct {
+		hi, lo uint64
+		want   Number
+	}{
+		{0, 0, Number{}},
+		{0x8000000000000000, 0, Number{-zero, 0}},
+		{0x3fff000000000000, 0, Number{1, 0}},
+		{0x3fff000000000000, 1, Number{1, 0x1p-112}},
+		{0x3ffeffffffffffff, 0xffffffffffffffff, Number{1, -0x1p-113}},
+		{0x3fff000000000000, 0x1000000000000001, Number{1 + 0x1p-52, 0x1p-112}},
+		{0x3fff000000000000, 0x1800000000000000, Number{1 + 0x1p-51, -0x1p-53}},
+		{0x3fff000000000000, 0x2800000000000000, Number{1 + 0x1p-51, 0x1p-53}},
+		{0x3fff000000000000, 0x1800000000000001, Number{1 + 0x1p-51, -0x1p-53}},
+		{0x4000921fb54442d1, 0x8469898cc51701b8, Pi},
+		{0x3bcc000000000000, 0, Number{}},
+		{0x3bcc000000000000, 1, Number{math.SmallestNonzeroFloat64, 0}},
+		{0xbbcc800000000000, 0, Number{-math.SmallestNonzeroFloat64, 0}},
+		{0x0000800000000000, 0, Number{}},
+		{0x8000000000000000, 1, Number{-zero, 0}},
+		{0x43feffffffffffff, 0xffffffffffffffff, Inf(+1)},
+		{0xc3feffffffffffff, 0xf7ffffffffffff00, Number{-math.MaxFloat64, -0x1.ffffffffffffcp969}},
+		{0xc3feffffffffffff, 0xf7ffffffffffffff, Inf(-1)},
+		{0x7ffeffffffffffff, 0xffffffffffffffff, Inf(+1)},
+		{0xffff000000000000, 0, Inf(-1)},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%016x%016x", tt.hi, tt.lo), func(t *testing.T) {
+			if got := FromFloat128Bits(tt.hi, tt.lo); !same(got, tt.want) {
+				t.Errorf("FromFloat128Bits() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromFloat128Bits_nan(t *testing.T) {
+	tests := []struct {
+		hi, lo uint64
+		want   uint64
+	}{
+		{0x7fff800000000000, 0, 0x7ff8000000000000},
+		{0xffff800000000000, 0, 0xfff8000000000000},
+		{0x7fff000000000000, 1, 0x7ff8000000000000},
+		{0x7fff123456789abc, 0xdef0000000000000, 0x7ff923456789abcd},
+		{0x7fffc00000000001, 0x2000000000000000, 0x7ffc000000000012},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%016x%016x", tt.hi, tt.lo), func(t *testing.T) {
+			got := FromFloat128Bits(tt.hi, tt.lo)
+			if b := math.Float64bits(got.y); !IsNaN(got) || b != tt.want || got.x != 0 {
+				t.Errorf("FromFloat128Bits() = %016x, want %016x", b, tt.want)
+			}
+			hi, lo := got.Float128Bits()
+			if b := math.Float64bits(FromFloat128Bits(hi, lo).y); b != tt.want {
+				t.Errorf("Float128Bits() = %016x%016x", hi, lo)
+			}
+		})
+	}
+}
+
+func TestFloat128Bits_roundTrip(t *testing.T) {
+	for _, n := range []Number{E, Pi, Phi, Sqrt2, Ln2, Ln10, Neg(Pi), Number{1, 0x1p-60}, Number{0x1p-1000, -0x1p-1060}} {
+		if got := FromFloat128Bits(n.Float128Bits()); !same(got, n) {
+			t.Errorf("FromFloat128Bits(%#v) = %#v", n, got)
+		}
+	}
+}
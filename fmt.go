@@ -1,10 +1,16 @@
 package dbldbl
 
-import "strconv"
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
 
 // String implements [fmt.Stringer].
+// It returns the shortest representation that [Parse] will return exactly,
+// like the '%v' verb.
 func (n Number) String() string {
-	return strconv.FormatFloat(n.y, 'g', 15, 64)
+	return FormatNumber(n, 'g', -1)
 }
 
 // GoString implements [fmt.GoStringer].
@@ -17,3 +23,491 @@ func (n Number) GoString() string {
 	}
 	return "Number{" + y + sep + x + "}"
 }
+
+// Format implements [fmt.Formatter].
+//
+// It accepts the verbs for floating-point numbers:
+// 'e', 'E', 'f', 'F', 'g', 'G', 'x', 'X' and 'v', with the same meaning,
+// and honoring the same width, precision and flags, as for a float64.
+// The verb 's' is the same as 'v', and '%#v' uses [Number.GoString].
+// Without an explicit precision, 'v' and 'g' use the shortest
+// representation that [Parse] will return exactly.
+func (n Number) Format(f fmt.State, verb rune) {
+	prec := -1
+	format := verb
+	switch verb {
+	case 'v', 's':
+		if verb == 'v' && f.Flag('#') {
+			io.WriteString(f, n.GoString())
+			return
+		}
+		format = 'g'
+	case 'g', 'G', 'x', 'X':
+	case 'e', 'E', 'f':
+		prec = 6
+	case 'F':
+		format = 'f'
+		prec = 6
+	default:
+		fmt.Fprintf(f, "%%!%c(dbldbl.Number=%s)", verb, n.String())
+		return
+	}
+	if p, ok := f.Precision(); ok {
+		prec = p
+	}
+
+	plus := f.Flag('+') && verb != 'v' // like float64, ignore plusV
+	space := f.Flag(' ')
+	minus := f.Flag('-')
+	zero := f.Flag('0') && !minus
+	wid, widPresent := f.Width()
+
+	// Format number, reserving space for leading + sign if needed.
+	var buf [64]byte
+	num := AppendNumber(buf[:1], n, byte(format), prec)
+	if num[1] == '-' || num[1] == '+' {
+		num = num[1:]
+	} else {
+		num[0] = '+'
+	}
+	// The space flag means to add a leading space instead of a "+" sign
+	// unless the sign is explicitly asked for by the plus flag.
+	if space && num[0] == '+' && !plus {
+		num[0] = ' '
+	}
+	// Special handling for infinities and NaN,
+	// which don't look like a number so shouldn't be padded with zeros.
+	if num[1] == 'I' || num[1] == 'N' {
+		// Remove sign before NaN if not asked for.
+		if num[1] == 'N' && !space && !plus {
+			num = num[1:]
+		}
+		pad(f, num, wid, minus, false)
+		return
+	}
+	// The sharp flag forces printing a decimal point
+	// and retains trailing zeros, which we may need to restore.
+	if f.Flag('#') {
+		digits := 0
+		switch format {
+		case 'g', 'G', 'x':
+			digits = prec
+			// If no precision is set explicitly use a precision of 6.
+			if digits == -1 {
+				digits = 6
+			}
+		}
+
+		var tail []byte
+		hasDecimalPoint := false
+		sawNonzeroDigit := false
+		// Starting from i = 1 to skip sign at num[0].
+		for i := 1; i < len(num); i++ {
+			switch num[i] {
+			case '.':
+				hasDecimalPoint = true
+			case 'p', 'P':
+				tail = append(tail, num[i:]...)
+				num = num[:i]
+			case 'e', 'E':
+				if format != 'x' && format != 'X' {
+					tail = append(tail, num[i:]...)
+					num = num[:i]
+					break
+				}
+				fallthrough
+			default:
+				if num[i] != '0' {
+					sawNonzeroDigit = true
+				}
+				// Count significant digits after the first non-zero digit.
+				if sawNonzeroDigit {
+					digits--
+				}
+			}
+		}
+		if !hasDecimalPoint {
+			// Leading digit 0 should contribute once to digits.
+			if len(num) == 2 && num[1] == '0' {
+				digits--
+			}
+			num = append(num, '.')
+		}
+		for ; digits > 0; digits-- {
+			num = append(num, '0')
+		}
+		num = append(num, tail...)
+	}
+	// We want a sign if asked for and if the sign is not positive.
+	if plus || num[0] != '+' {
+		// If we're zero padding to the left we want the sign before the leading zeros.
+		// Achieve this by writing the sign out and then padding the unsigned number.
+		if zero && widPresent && wid > len(num) {
+			f.Write(num[:1])
+			pad(f, num[1:], wid-1, false, true)
+			return
+		}
+		pad(f, num, wid, minus, zero)
+		return
+	}
+	// No sign to show and the number is positive; just print the unsigned number.
+	pad(f, num[1:], wid, minus, zero)
+}
+
+// pad writes b to w, padded to width on the left (or right, if minus).
+func pad(w io.Writer, b []byte, width int, minus, zero bool) {
+	var padding [64]byte
+	padByte := byte(' ')
+	if zero {
+		padByte = '0'
+	}
+	if minus {
+		w.Write(b)
+	}
+	for n := width - len(b); n > 0; n -= len(padding) {
+		p := padding[:min(n, len(padding))]
+		for i := range p {
+			p[i] = padByte
+		}
+		w.Write(p)
+	}
+	if !minus {
+		w.Write(b)
+	}
+}
+
+// FormatNumber converts the Number n to a string,
+// according to the format fmt and precision prec.
+//
+// The format fmt is one of
+//   - 'e' (-d.dddde±dd, a decimal exponent),
+//   - 'E' (-d.ddddE±dd, a decimal exponent),
+//   - 'f' (-ddd.dddd, no exponent),
+//   - 'g' ('e' for large exponents, 'f' otherwise),
+//   - 'G' ('E' for large exponents, 'f' otherwise),
+//   - 'x' (-0x1.ddddp±ddd, a hexadecimal fraction and binary exponent), or
+//   - 'X' (-0X1.ddddP±ddd, a hexadecimal fraction and binary exponent).
+//
+// The precision prec controls the number of digits (excluding the exponent),
+// as for [strconv.FormatFloat]; digits are correctly rounded, to nearest even.
+// The special precision -1 uses the smallest number of digits
+// necessary such that [Parse] will return n exactly.
+//
+// That is usually about 32 significant digits, but it can be many more
+// when the low part of n is zero or tiny, because Parse keeps the
+// remainder that any shorter decimal would leave in the low part.
+// For example, Float(0.1) is formatted with all 55 significant digits
+// of its exact value, 0.1000000000000000055511151231257827021181583404541015625,
+// and Float(math.MaxFloat64) with all 309; Parse("0.1"), on the other hand,
+// is formatted as 0.1. For a bounded number of digits, which need not
+// round-trip, use a precision such as 32.
+func FormatNumber(n Number, fmt byte, prec int) string {
+	return string(AppendNumber(make([]byte, 0, max(prec+8, 40)), n, fmt, prec))
+}
+
+// AppendNumber appends the string form of the Number n,
+// as generated by [FormatNumber], to dst and returns the extended buffer.
+func AppendNumber(dst []byte, n Number, fmt byte, prec int) []byte {
+	switch {
+	case IsNaN(n):
+		return append(dst, "NaN"...)
+	case IsInf(n, +1):
+		return append(dst, "+Inf"...)
+	case IsInf(n, -1):
+		return append(dst, "-Inf"...)
+	}
+
+	neg := Signbit(n)
+	if neg {
+		n = Neg(n)
+	}
+
+	if fmt == 'x' || fmt == 'X' {
+		return fmtX(dst, prec, fmt, neg, n)
+	}
+
+	// Pick off zero.
+	if n.y == 0 {
+		return fmtEFG(dst, neg, nil, 0, 0, prec, fmt, prec < 0)
+	}
+
+	var d decimal
+	if prec < 0 {
+		d.setShortest(n)
+		return fmtEFG(dst, neg, d.d[:], d.dp, d.nd, prec, fmt, true)
+	}
+
+	// Exact value, then round.
+	var z nat
+	_, e := split(n.y)
+	if n.x != 0 {
+		_, e = split(n.x)
+	}
+	z.setNumber(n, e)
+	d.set(&z, e)
+
+	switch fmt {
+	case 'e', 'E':
+		d.Round(prec + 1)
+	case 'f':
+		d.Round(d.dp + prec)
+	case 'g', 'G':
+		if prec == 0 {
+			prec = 1
+		}
+		d.Round(prec)
+	}
+	return fmtEFG(dst, neg, d.d[:], d.dp, d.nd, prec, fmt, false)
+}
+
+// fmtEFG formats the nd digits in s, with the decimal point at dp.
+func fmtEFG(dst []byte, neg bool, s []byte, dp, nd, prec int, fmt byte, shortest bool) []byte {
+	if shortest {
+		prec = nd
+		switch fmt {
+		case 'e', 'E':
+			prec = max(nd-1, 0)
+		case 'f':
+			prec = max(nd-dp, 0)
+		}
+	}
+
+	if fmt == 'g' || fmt == 'G' {
+		// %e is used if the exponent from the conversion
+		// is less than -4 or greater than or equal to the precision.
+		// If precision was the shortest possible, use precision 6 for this decision.
+		eprec := prec
+		if eprec > nd && nd >= dp {
+			eprec = nd
+		}
+		if shortest {
+			eprec = 6
+		}
+		exp := dp - 1
+		if exp < -4 || exp >= eprec {
+			if prec > nd {
+				prec = nd
+			}
+			prec = max(prec-1, 0)
+			fmt = fmt + 'e' - 'g'
+		} else {
+			if prec > dp {
+				prec = nd
+			}
+			prec = max(prec-dp, 0)
+			fmt = 'f'
+		}
+	}
+
+	switch fmt {
+	case 'e', 'E': // %e: -d.ddddde±dd
+		if neg {
+			dst = append(dst, '-')
+		}
+
+		// first digit
+		ch := byte('0')
+		if nd != 0 {
+			ch = s[0]
+		}
+		dst = append(dst, ch)
+
+		// .moredigits
+		if prec > 0 {
+			dst = append(dst, '.')
+			i := 1
+			m := min(nd, prec+1)
+			if i < m {
+				dst = append(dst, s[i:m]...)
+				i = m
+			}
+			for range prec + 1 - i {
+				dst = append(dst, '0')
+			}
+		}
+
+		// e±
+		dst = append(dst, fmt)
+		exp := dp - 1
+		if nd == 0 { // special case: 0 has exponent 0
+			exp = 0
+		}
+		if exp < 0 {
+			ch = '-'
+			exp = -exp
+		} else {
+			ch = '+'
+		}
+		dst = append(dst, ch)
+		if exp < 10 {
+			dst = append(dst, '0')
+		}
+		return strconv.AppendInt(dst, int64(exp), 10)
+
+	case 'f': // %f: -ddddddd.ddddd
+		if neg {
+			dst = append(dst, '-')
+		}
+
+		// integer, padded with zeros as needed.
+		if dp > 0 {
+			m := min(nd, dp)
+			dst = append(dst, s[:m]...)
+			for range dp - m {
+				dst = append(dst, '0')
+			}
+		} else {
+			dst = append(dst, '0')
+		}
+
+		// fraction
+		if prec > 0 {
+			dst = append(dst, '.')
+			lz := min(prec, max(0, -dp)) // leading zeros
+			off := dp + lz
+			m := min(prec-lz, max(0, nd-off)) // middle digits
+			tz := max(0, prec-lz-m)           // trailing zeros
+			for range lz {
+				dst = append(dst, '0')
+			}
+			if m > 0 {
+				dst = append(dst, s[off:off+m]...)
+			}
+			for range tz {
+				dst = append(dst, '0')
+			}
+		}
+		return dst
+	}
+
+	// unknown format
+	return append(dst, '%', fmt)
+}
+
+// %x: -0x1.yyyyyyyyp±ddd or -0x0p+00. (y is hex digit, d is decimal digit)
+func fmtX(dst []byte, prec int, fmt byte, neg bool, n Number) []byte {
+	// The bits of n, most significant first;
+	// n is canonical, so they span at most 53+2045 bits.
+	var bits [2112]byte
+	var nb, exp int
+	if n.y != 0 {
+		var z [2]uint64
+		mh, eh := split(n.y)
+		exp = eh
+		z[0] = mh
+		nb = 53
+		if n.x != 0 {
+			ml, el := split(n.x)
+			exp = el
+			nb += eh - el
+			// Subtract, borrowing from the high part.
+			if n.x < 0 {
+				z[0]--
+				ml = 1<<(eh-el) - ml
+			}
+			z[1] = ml
+		}
+		for i := range 53 {
+			bits[i] = byte(z[0] >> (52 - i) & 1)
+		}
+		for i := range min(nb-53, 64) {
+			bits[nb-1-i] = byte(z[1] >> i & 1)
+		}
+		if n.x < 0 && nb-53 > 64 {
+			// The borrow propagates as a run of ones.
+			for i := 53; i < nb-64; i++ {
+				bits[i] = 1
+			}
+		}
+	}
+
+	// Skip leading zeros.
+	var lead int
+	for lead < nb && bits[lead] == 0 {
+		lead++
+	}
+	if lead == nb {
+		exp = 0
+		lead, nb = 0, 0
+	} else {
+		exp += nb - lead - 1
+		lead++
+	}
+	frac := bits[lead:nb]
+
+	// Round if requested.
+	if prec >= 0 && 4*prec < len(frac) {
+		cut := 4 * prec
+		half := frac[cut] == 1
+		sticky := false
+		for _, b := range frac[cut+1:] {
+			if b != 0 {
+				sticky = true
+				break
+			}
+		}
+		odd := cut == 0 && nb > 0 || cut > 0 && frac[cut-1] == 1
+		frac = frac[:cut]
+		if half && (sticky || odd) {
+			i := cut - 1
+			for ; i >= 0 && frac[i] == 1; i-- {
+				frac[i] = 0
+			}
+			if i >= 0 {
+				frac[i] = 1
+			} else {
+				// Wrapped around.
+				exp++
+			}
+		}
+	}
+
+	hex := "0123456789abcdef"
+	if fmt == 'X' {
+		hex = "0123456789ABCDEF"
+	}
+
+	// sign, 0x, leading digit
+	if neg {
+		dst = append(dst, '-')
+	}
+	lead1 := byte('0')
+	if nb > 0 {
+		lead1 = '1'
+	}
+	dst = append(dst, '0', fmt, lead1)
+
+	// .fraction
+	if prec < 0 {
+		for len(frac) > 0 && frac[len(frac)-1] == 0 {
+			frac = frac[:len(frac)-1]
+		}
+		prec = (len(frac) + 3) / 4
+	}
+	if prec > 0 {
+		dst = append(dst, '.')
+		for i := range prec {
+			var nibble byte
+			for j := range 4 {
+				nibble <<= 1
+				if k := 4*i + j; k < len(frac) {
+					nibble |= frac[k]
+				}
+			}
+			dst = append(dst, hex[nibble])
+		}
+	}
+
+	// p±
+	dst = append(dst, fmt+'p'-'x')
+	ch := byte('+')
+	if exp < 0 {
+		ch = '-'
+		exp = -exp
+	}
+	dst = append(dst, ch)
+	if exp < 10 {
+		dst = append(dst, '0')
+	}
+	return strconv.AppendInt(dst, int64(exp), 10)
+}
@@ -2,6 +2,8 @@ package dbldbl
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"testing"
 )
 
@@ -11,8 +13,11 @@ func TestNumber_String(t *testing.T) {
 		arg  Number
 		want string
 	}{
-		{"E", E, "2.71828182845905"},
-		{"Pi", Pi, "3.14159265358979"},
+		{"E", E, "2.71828182845904523536028747135266"},
+		{"Pi", Pi, "3.1415926535897932384626433832795"},
+		{"Tenth", parse("0.1"), "0.1"},
+		{"Million", Float(1e6), "1e+06"},
+		{"Inf", Inf(-1), "-Inf"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -42,12 +47,161 @@ func TestNumber_GoString(t *testing.T) {
 	}
 }
 
-func (n Number) Format(f fmt.State, verb rune) {
-	if verb == 'v' && f.Flag('#') {
-		f.Write([]byte(n.GoString()))
-	} else if IsNaN(n) {
-		f.Write([]byte("NaN"))
-	} else {
-		n.toBig().Format(f, verb)
+func TestNumber_Format(t *testing.T) {
+	tests := []struct {
+		format string
+		arg    Number
+		want   string
+	}{
+		{"%v", Pi, "3.1415926535897932384626433832795"},
+		{"%s", Pi, "3.1415926535897932384626433832795"},
+		{"%#v", Pi, "Number{3.141592653589793, +0x1.1a62633145c07p-53}"},
+		{"%.30f", Pi, "3.141592653589793238462643383280"},
+		{"%.30F", Pi, "3.141592653589793238462643383280"},
+		{"%f", Pi, "3.141593"},
+		{"%e", Pi, "3.141593e+00"},
+		{"%.20E", Pi, "3.14159265358979323846E+00"},
+		{"%.25g", Pi, "3.141592653589793238462643"},
+		{"%G", parse("1e-100"), "1E-100"},
+		{"%v", parse("1e21"), "1e+21"},
+		{"%v", Float(123456), "123456"},
+		{"%v", Float(1234567), "1.234567e+06"},
+		{"%x", Float(3), "0x1.8p+01"},
+		{"%.3X", Pi, "0X1.922P+01"},
+		{"%+.3f", Pi, "+3.142"},
+		{"% .3f", Pi, " 3.142"},
+		{"%+ .3f", Pi, "+3.142"},
+		{"%10.3f", Pi, "     3.142"},
+		{"%-10.3f|", Pi, "3.142     |"},
+		{"%010.3f", Neg(Pi), "-00003.142"},
+		{"%+010.3f", Pi, "+00003.142"},
+		{"%#.3g", Float(1), "1.00"},
+		{"%#g", Float(1), "1.00000"},
+		{"%#.0f", Float(1), "1."},
+		{"%#.0e", Float(1), "1.e+00"},
+		{"%v", Float(-zero), "-0"},
+		{"%5v", NaN(), "  NaN"},
+		{"%+e", NaN(), "+NaN"},
+		{"%+v", Pi, "3.1415926535897932384626433832795"},
+		{"%05v", Inf(-1), " -Inf"},
+		{"%-6v|", Inf(+1), "+Inf  |"},
+		{"%d", Pi, "%!d(dbldbl.Number=3.1415926535897932384626433832795)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.arg); got != tt.want {
+				t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumber_Format_float(t *testing.T) {
+	// Numbers that are exactly representable in few digits.
+	floats := []float64{0, 1, -1, 0.25, 1.5, 1e21, 0x1p-20, math.Inf(1), math.Inf(-1), math.NaN()}
+	formats := []string{"%v", "%e", "%E", "%f", "%F", "%g", "%G", "%x", "%X",
+		"%+v", "% v", "%+ v", "%-12v|", "%12v", "%012v", "%+012v", "%#v",
+		"%.3e", "%#.3g", "%#x", "%#.0f", "%-+12.2f|", "%012.4x"}
+	for _, f := range floats {
+		for _, format := range formats {
+			want := fmt.Sprintf(format, f)
+			if format == "%#v" {
+				want = Float(f).GoString()
+			}
+			if got := fmt.Sprintf(format, Float(f)); got != want {
+				t.Errorf("Sprintf(%q, %v) = %q, want %q", format, f, got, want)
+			}
+		}
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		fmt  byte
+		prec int
+		want string
+	}{
+		{Pi, 'g', -1, "3.1415926535897932384626433832795"},
+		{Pi, 'e', 31, "3.1415926535897932384626433832795e+00"},
+		{Pi, 'f', 35, "3.14159265358979323846264338327950588"},
+		{Pi, 'g', 20, "3.1415926535897932385"},
+		{Pi, 'x', -1, "0x1.921fb54442d18469898cc51701cp+01"},
+		{Pi, 'X', 4, "0X1.9220P+01"},
+		{Neg(E), 'G', 25, "-2.718281828459045235360287"},
+		{Neg(E), 'e', -1, "-2.71828182845904523536028747135266e+00"},
+		{Float(0.1), 'g', -1, "0.1000000000000000055511151231257827021181583404541015625"},
+		{parse("0.1"), 'g', -1, "0.1"},
+		{parse("1e100"), 'g', -1, "1e+100"},
+		{parse("1e-100"), 'f', 5, "0.00000"},
+		{Float(1e23), 'f', -1, "99999999999999991611392"},
+		{Float(5e-324), 'g', -1, "5e-324"},
+		{AddFloats(1, 0x1p-200), 'x', -1, "0x1.00000000000000000000000000000000000000000000000001p+00"},
+		{AddFloats(1, -0x1p-200), 'x', 10, "0x1.0000000000p+00"},
+		{AddFloats(1, -0x1p-200), 'x', -1, "0x1.fffffffffffffffffffffffffffffffffffffffffffffffffep-01"},
+		{AddFloats(0x1p-1022, 0x1p-1074), 'g', -1, "2.225073858507202e-308"},
+		{Number{0.5, 0x1p-54}, 'e', -1, "5.0000000000000005551115123125783e-01"},
+		{Number{0.625, -0x1p-54}, 'e', -1, "6.2499999999999994448884876874217e-01"},
+		{Number{3.019664087873109e+237, -0x1p+735}, 'e', -1, "3.0196640878731086472742158273591e+237"},
+		{Number{}, 'e', -1, "0e+00"},
+		{Float(-zero), 'g', 3, "-0"},
+		{Number{}, 'x', 2, "0x0.00p+00"},
+		{Inf(+1), 'g', -1, "+Inf"},
+		{Inf(-1), 'e', 5, "-Inf"},
+		{NaN(), 'f', 5, "NaN"},
+		{Pi, 'q', 5, "%q"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := FormatNumber(tt.arg, tt.fmt, tt.prec); got != tt.want {
+				t.Errorf("FormatNumber() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatNumber_float(t *testing.T) {
+	floats := []float64{0, 1, -1, 0.1, 1.5, 2.5, 1e23, 5e-324, math.MaxFloat64, math.Pi, -math.E * 1e-300}
+	for _, f := range floats {
+		for _, fmt := range []byte{'e', 'E', 'f', 'g', 'G', 'x', 'X'} {
+			for _, prec := range []int{0, 1, 5, 15, 17, 30} {
+				want := strconv.FormatFloat(f, fmt, prec, 64)
+				if got := FormatNumber(Float(f), fmt, prec); got != want {
+					t.Errorf("FormatNumber(%v, %c, %d) = %q, want %q", f, fmt, prec, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestFormatNumber_roundTrip(t *testing.T) {
+	tests := []Number{
+		E, Pi, Phi, Sqrt2, Ln2, Ln10, twoOfPi,
+		AddFloats(1, 0x1p-53), AddFloats(1, -0x1p-54),
+		AddFloats(1, 0x1p-1074), AddFloats(1e300, 1e200),
+		AddFloats(0x1p-1022, 0x1p-1074), Float(math.SmallestNonzeroFloat64),
+		Number{math.MaxFloat64, 0x1p969}, Neg(Ldexp(Pi, -1000)),
+		Number{0.5, 0x1p-54}, Number{0.625, -0x1p-54},
+		Number{3.019664087873109e+237, -0x1p+735},
+	}
+	for _, n := range tests {
+		for _, fmt := range []byte{'e', 'f', 'g', 'x'} {
+			s := FormatNumber(n, fmt, -1)
+			if got, err := Parse(s); err != nil || !same(got, n) {
+				t.Errorf("Parse(%q) = %#v, want %#v", s, got, n)
+			}
+		}
+	}
+}
+
+func TestAppendNumber(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = AppendNumber(buf[:0], Pi, 'g', -1)
+		buf = AppendNumber(buf[:0], E, 'f', 40)
+		buf = AppendNumber(buf[:0], Phi, 'x', -1)
+	})
+	if allocs != 0 {
+		t.Errorf("AppendNumber() allocs = %v", allocs)
 	}
 }
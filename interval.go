@@ -0,0 +1,420 @@
+package dbldbl
+
+import "math"
+
+// Interval is a closed interval of real numbers, [Lo, Hi].
+//
+// A Lo of -Inf, or a Hi of +Inf, makes the interval unbounded.
+// An interval is empty if Lo > Hi, Lo is +Inf, Hi is -Inf,
+// or either bound is NaN.
+//
+// Operations on intervals round outward, using the directed rounding
+// variants of the basic operations: the result contains the result
+// of the operation on every member of its operands.
+// Operations on empty intervals return the empty interval.
+type Interval struct {
+	Lo, Hi Number
+}
+
+// PointInterval returns the interval [n, n].
+func PointInterval(n Number) Interval {
+	return Interval{n, n}
+}
+
+// EmptyInterval returns the empty interval.
+func EmptyInterval() Interval {
+	return Interval{Inf(+1), Inf(-1)}
+}
+
+// EntireInterval returns the interval of all real numbers, [-Inf, +Inf].
+func EntireInterval() Interval {
+	return Interval{Inf(-1), Inf(+1)}
+}
+
+// IsEmpty reports whether i is empty.
+func (i Interval) IsEmpty() bool {
+	return IsNaN(i.Lo) || IsNaN(i.Hi) || IsInf(i.Lo, +1) || IsInf(i.Hi, -1) ||
+		Cmp(i.Lo, i.Hi) > 0
+}
+
+// Contains reports whether n is a member of i.
+func (i Interval) Contains(n Number) bool {
+	return !i.IsEmpty() && !IsNaN(n) && Cmp(i.Lo, n) <= 0 && Cmp(n, i.Hi) <= 0
+}
+
+// Intersect returns the intersection of i and j.
+func (i Interval) Intersect(j Interval) Interval {
+	if i.IsEmpty() || j.IsEmpty() {
+		return EmptyInterval()
+	}
+	r := Interval{Max(i.Lo, j.Lo), Min(i.Hi, j.Hi)}
+	if r.IsEmpty() {
+		return EmptyInterval()
+	}
+	return r
+}
+
+// Hull returns the smallest interval that contains both i and j.
+func (i Interval) Hull(j Interval) Interval {
+	switch {
+	case i.IsEmpty():
+		if j.IsEmpty() {
+			return EmptyInterval()
+		}
+		return j
+	case j.IsEmpty():
+		return i
+	}
+	return Interval{Min(i.Lo, j.Lo), Max(i.Hi, j.Hi)}
+}
+
+// Neg returns the interval of the negations of the members of i (exact).
+func (i Interval) Neg() Interval {
+	if i.IsEmpty() {
+		return EmptyInterval()
+	}
+	return Interval{Neg(i.Hi), Neg(i.Lo)}
+}
+
+// Add returns an interval that contains the sums of members of i and j.
+func (i Interval) Add(j Interval) Interval {
+	if i.IsEmpty() || j.IsEmpty() {
+		return EmptyInterval()
+	}
+	return Interval{AddDown(i.Lo, j.Lo), AddUp(i.Hi, j.Hi)}
+}
+
+// Sub returns an interval that contains the differences of members of i and j.
+func (i Interval) Sub(j Interval) Interval {
+	return i.Add(j.Neg())
+}
+
+// Mul returns an interval that contains the products of members of i and j.
+func (i Interval) Mul(j Interval) Interval {
+	if i.IsEmpty() || j.IsEmpty() {
+		return EmptyInterval()
+	}
+
+	// Zero times infinity is zero: the bounds of an unbounded interval
+	// aren't members of it, so only finite numbers are multiplied.
+	prod := func(a, b Number, dir int) Number {
+		if a.y == 0 || b.y == 0 {
+			return Number{}
+		}
+		return mul(a, b, dir)
+	}
+
+	lo := Min(
+		Min(prod(i.Lo, j.Lo, -1), prod(i.Lo, j.Hi, -1)),
+		Min(prod(i.Hi, j.Lo, -1), prod(i.Hi, j.Hi, -1)))
+	hi := Max(
+		Max(prod(i.Lo, j.Lo, +1), prod(i.Lo, j.Hi, +1)),
+		Max(prod(i.Hi, j.Lo, +1), prod(i.Hi, j.Hi, +1)))
+	return Interval{lo, hi}
+}
+
+// Div returns an interval that contains the quotients of members of i and j.
+//
+// If j contains zero, the result is the smallest interval
+// that contains the quotients by its nonzero members,
+// which is unbounded, or empty if j is [0, 0].
+func (i Interval) Div(j Interval) Interval {
+	if i.IsEmpty() || j.IsEmpty() {
+		return EmptyInterval()
+	}
+
+	switch {
+	case j.Lo.y > 0:
+		switch {
+		case i.Lo.y >= 0:
+			return Interval{DivDown(i.Lo, j.Hi), DivUp(i.Hi, j.Lo)}
+		case i.Hi.y <= 0:
+			return Interval{DivDown(i.Lo, j.Lo), DivUp(i.Hi, j.Hi)}
+		default:
+			return Interval{DivDown(i.Lo, j.Lo), DivUp(i.Hi, j.Lo)}
+		}
+
+	case j.Hi.y < 0:
+		switch {
+		case i.Lo.y >= 0:
+			return Interval{DivDown(i.Hi, j.Hi), DivUp(i.Lo, j.Lo)}
+		case i.Hi.y <= 0:
+			return Interval{DivDown(i.Hi, j.Lo), DivUp(i.Lo, j.Hi)}
+		default:
+			return Interval{DivDown(i.Hi, j.Hi), DivUp(i.Lo, j.Hi)}
+		}
+
+	// j contains zero.
+	case j.Lo.y == 0 && j.Hi.y == 0:
+		return EmptyInterval()
+	case i.Lo.y <= 0 && i.Hi.y >= 0, j.Lo.y < 0 && j.Hi.y > 0:
+		return EntireInterval()
+
+	// j is [0, hi] or [lo, 0].
+	case j.Lo.y == 0:
+		if i.Lo.y > 0 {
+			return Interval{DivDown(i.Lo, j.Hi), Inf(+1)}
+		}
+		return Interval{Inf(-1), DivUp(i.Hi, j.Hi)}
+	default:
+		if i.Lo.y > 0 {
+			return Interval{Inf(-1), DivUp(i.Lo, j.Lo)}
+		}
+		return Interval{DivDown(i.Hi, j.Lo), Inf(+1)}
+	}
+}
+
+// Sqrt returns an interval that contains the square roots
+// of the nonnegative members of i.
+func (i Interval) Sqrt() Interval {
+	i = i.Intersect(Interval{Number{}, Inf(+1)})
+	if i.IsEmpty() {
+		return EmptyInterval()
+	}
+	return Interval{SqrtDown(i.Lo), SqrtUp(i.Hi)}
+}
+
+// The enclosures of the elementary functions evaluate the same
+// reductions and series as the approximations, with outward rounding:
+// the argument reductions are exact, or have their error bounded,
+// the tables and constants have their rounding error added,
+// and the rest of each series is bounded by its leading term.
+
+// Exp returns an interval that contains eⁿ, for all members n of i.
+func (i Interval) Exp() Interval {
+	if i.IsEmpty() {
+		return EmptyInterval()
+	}
+	return Interval{expEnclosure(i.Lo).Lo, expEnclosure(i.Hi).Hi}
+}
+
+// Log returns an interval that contains the natural logarithms
+// of the positive members of i.
+func (i Interval) Log() Interval {
+	i = i.Intersect(Interval{Number{}, Inf(+1)})
+	if i.IsEmpty() {
+		return EmptyInterval()
+	}
+	r := Interval{logEnclosure(i.Lo).Lo, logEnclosure(i.Hi).Hi}
+	if r.IsEmpty() {
+		return EmptyInterval() // the logarithm of [0, 0]
+	}
+	return r
+}
+
+// Sin returns an interval that contains the sines of the members of i.
+func (i Interval) Sin() Interval {
+	// The sine has its maxima at π⋅(2⋅k + ½), and its minima at π⋅(2⋅k + 1 + ½).
+	return i.periodic(func(n Number) Interval {
+		sin, _ := sincosEnclosure(n)
+		return sin
+	}, 0.5)
+}
+
+// Cos returns an interval that contains the cosines of the members of i.
+func (i Interval) Cos() Interval {
+	// The cosine has its maxima at π⋅2⋅k, and its minima at π⋅(2⋅k + 1).
+	return i.periodic(func(n Number) Interval {
+		_, cos := sincosEnclosure(n)
+		return cos
+	}, 0)
+}
+
+// periodic returns an interval that contains f(n), for all members n of i,
+// where f encloses the sine or the cosine, with its extrema at π⋅(k + phase),
+// maxima for even k, and minima for odd k.
+func (i Interval) periodic(f func(Number) Interval, phase float64) Interval {
+	if i.IsEmpty() {
+		return EmptyInterval()
+	}
+
+	a, b := f(i.Lo), f(i.Hi)
+	lo := Max(Min(a.Lo, b.Lo), Float(-1))
+	hi := Min(Max(a.Hi, b.Hi), Float(1))
+	if i.Lo == i.Hi {
+		// Skip the extrema, which can't be told apart
+		// from large points by dividing by π.
+		return Interval{lo, hi}
+	}
+
+	// The extrema that i may contain are those with k in [klo, khi].
+	pi := Interval{Number{Pi.y, math.Nextafter(Pi.x, math.Inf(-1))}, Number{Pi.y, math.Nextafter(Pi.x, math.Inf(+1))}}
+	klo := Ceil(AddFloatDown(PointInterval(i.Lo).Div(pi).Lo, -phase))
+	khi := Floor(AddFloatUp(PointInterval(i.Hi).Div(pi).Hi, -phase))
+
+	switch c := Cmp(klo, khi); {
+	case c > 0:
+		// f is monotonic on i.
+		return Interval{lo, hi}
+	case c < 0:
+		// i may contain a maximum and a minimum.
+		return Interval{Float(-1), Float(1)}
+	}
+
+	// i may contain a single extremum.
+	if isOdd(klo) {
+		return Interval{Float(-1), hi}
+	}
+	return Interval{lo, Float(1)}
+}
+
+// expEnclosure returns an interval that contains eⁿ.
+func expEnclosure(n Number) Interval {
+	switch {
+	case IsInf(n, 0) || n.y == 0:
+		return PointInterval(Exp(n)) // exact
+	case n.y > 710:
+		return Interval{overflow(Inf(+1), -1), Inf(+1)}
+	case n.y < -746:
+		return Interval{Number{}, Float(math.SmallestNonzeroFloat64)}
+	}
+
+	// n = k⋅log(2)/64 + r, as in expReduced, with r computed exactly
+	// from the log(2)/64 triple, which is off by less than 2⁻¹⁵².
+	k := math.Round(n.y * (64 / math.Ln2))
+	var e expansion
+	e.add(n.y)
+	e.add(n.x)
+	e.mulAdd(-k, ln2By64Hi)
+	e.mulAdd(-k, ln2By64Mid)
+	e.mulAdd(-k, ln2By64Lo)
+	s, _ := e.round()
+	r := Interval{e.nudge(s, 1, -1), e.nudge(s, 1, +1)}.widen(math.Abs(k) * 0x1p-152)
+
+	// eʳ = 1 + r⋅(1 + r/2⋅(1 + … (1 + r/11))), and since |r| < 0.0055,
+	// the rest of the series is less than 2⁻¹¹⁸.
+	p := series(r, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11).widen(0x1p-118)
+
+	// eⁿ = 2ʲ⋅2ⁱᐟ⁶⁴⋅eʳ, with k = 64⋅j + i,
+	// and table entries off by less than 2⁻¹⁰⁵.
+	t := PointInterval(exp2Table[int(k)&63]).widen(0x1p-105)
+	x := t.Mul(p)
+	j := int(k) >> 6
+	return Interval{Max(ldexpBound(x.Lo, j, -1), Number{}), ldexpBound(x.Hi, j, +1)}
+}
+
+// logEnclosure returns an interval that contains log(n), for n ≥ 0.
+func logEnclosure(n Number) Interval {
+	if n.y == 0 || !isFinite(n.y) || n == Float(1) {
+		return PointInterval(Log(n)) // exact
+	}
+
+	// n = (1+f)⋅2ᵏ, with 1+f in [¾, 1½), as in Log.
+	// If the low part of n underflows in this scaling,
+	// the logarithm changes by less than 2⁻¹⁰⁷².
+	m, k := Frexp(n)
+	if m.y < 0.75 {
+		m = shift(m, 1)
+		k--
+	}
+	f := twoSum(m.y-1, m.x)
+
+	// 1+f = (1+t)/r, as in logReduced, with t = (r-1) + f⋅r exact.
+	l := &logTable[int(math.Round(f.y*128))+32]
+	var e expansion
+	e.add(l.r - 1)
+	e.mulAdd(f.y, l.r)
+	e.mulAdd(f.x, l.r)
+	v, _ := e.round()
+	t := Interval{e.nudge(v, 1, -1), e.nudge(v, 1, +1)}
+
+	// log(1+t) = 2⋅s⋅(1 + z/3 + z²/5 + … + z⁶/13), with s = t/(2+t) and z = s²,
+	// and since |s| < 2⁻⁸·⁵, the rest of the series is less than 2⁻¹²¹⋅|s|.
+	s := t.Div(t.Add(PointInterval(Float(2))))
+	z := s.Mul(s)
+	q := PointInterval(Float(1)).Div(PointInterval(Float(13)))
+	for d := 11.0; d > 0; d -= 2 {
+		q = z.Mul(q).Add(PointInterval(Float(1)).Div(PointInterval(Float(d))))
+	}
+	q = s.Mul(q).Mul(PointInterval(Float(2)))
+	q = q.widen(s.mag()*0x1p-121 + 0x1p-1072)
+
+	// log(n) = k⋅log(2) - log(r) + log(1+t), where the log(2) triple
+	// and the table entries are off by less than 2⁻¹⁶⁰.
+	var c expansion
+	c.mulAdd(float64(k), ln2Hi)
+	c.mulAdd(float64(k), ln2Mid)
+	c.mulAdd(float64(k), ln2Lo)
+	c.add(l.log.y)
+	c.add(l.log.x)
+	c.add(l.lo)
+	err := math.Abs(float64(k)) * 0x1p-160
+	if l.r != 1 {
+		err += 0x1p-160
+	}
+	v, _ = c.round()
+	return Interval{c.nudge(v, 1, -1), c.nudge(v, 1, +1)}.widen(err).Add(q)
+}
+
+// sincosEnclosure returns intervals that contain sin(n) and cos(n).
+func sincosEnclosure(n Number) (sin, cos Interval) {
+	switch {
+	case n.y == 0:
+		return PointInterval(n), PointInterval(Float(1)) // exact
+	case !isFinite(n.y):
+		return Interval{Float(-1), Float(1)}, Interval{Float(-1), Float(1)}
+	}
+
+	// n = k⋅π/2 + θ, as in Sincos.
+	k, θ, err := trigReduce(n)
+	t := PointInterval(θ).widen(err)
+
+	// sin(θ) = θ⋅(1 - z/(2⋅3)⋅(1 - z/(4⋅5)⋅(1 - …))), up to θ²⁹,
+	// cos(θ) = 1 - z/(1⋅2)⋅(1 - z/(3⋅4)⋅(1 - …)), up to θ²⁸, with z = θ²,
+	// and since |θ| < 0.79, the rest of the series are less than
+	// 2⁻¹²²⋅|θ| and 2⁻¹¹⁷.
+	z := t.Mul(t).Neg()
+	sin = t.Mul(series(z, 2*3, 4*5, 6*7, 8*9, 10*11, 12*13, 14*15, 16*17, 18*19, 20*21, 22*23, 24*25, 26*27, 28*29))
+	sin = sin.widen(t.mag()*0x1p-122 + 0x1p-1074)
+	cos = series(z, 1*2, 3*4, 5*6, 7*8, 9*10, 11*12, 13*14, 15*16, 17*18, 19*20, 21*22, 23*24, 25*26, 27*28)
+	cos = cos.widen(0x1p-117)
+
+	switch k & 3 {
+	default:
+		return sin, cos
+	case 1:
+		return cos, sin.Neg()
+	case 2:
+		return sin.Neg(), cos.Neg()
+	case 3:
+		return cos.Neg(), sin
+	}
+}
+
+// series returns an interval that contains
+// 1 + x/d₁⋅(1 + x/d₂⋅(1 + … (1 + x/dₘ))), for the divisors d.
+func series(x Interval, d ...float64) Interval {
+	one := PointInterval(Float(1))
+	p := one
+	for i := len(d) - 1; i >= 0; i-- {
+		p = x.Mul(p).Div(PointInterval(Float(d[i]))).Add(one)
+	}
+	return p
+}
+
+// ldexpBound returns a bound of n⋅2ʲ in direction dir.
+func ldexpBound(n Number, j int, dir int) Number {
+	r := Ldexp(n, j)
+	switch {
+	case IsInf(r, 0):
+		return overflow(r, dir)
+	case Ldexp(r, -j) != n:
+		// Bits below 2⁻¹⁰⁷⁴ were lost.
+		return add(r, Float(float64(dir)*math.SmallestNonzeroFloat64), dir)
+	}
+	return r
+}
+
+// widen returns an interval that contains the members of i,
+// and the numbers within err of them.
+func (i Interval) widen(err float64) Interval {
+	if err == 0 {
+		return i
+	}
+	return Interval{AddFloatDown(i.Lo, -err), AddFloatUp(i.Hi, err)}
+}
+
+// mag returns a bound on the magnitude of the members of i.
+func (i Interval) mag() float64 {
+	return math.Max(math.Abs(i.Lo.y), math.Abs(i.Hi.y)) * (1 + 0x1p-52)
+}
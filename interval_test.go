@@ -0,0 +1,339 @@
+package dbldbl
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func iv(lo, hi float64) Interval {
+	return Interval{Float(lo), Float(hi)}
+}
+
+func sameInterval(a, b Interval) bool {
+	return same(a.Lo, b.Lo) && same(a.Hi, b.Hi)
+}
+
+func TestInterval_IsEmpty(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		arg  Interval
+		want bool
+	}{
+		{iv(1, 2), false},
+		{iv(1, 1), false},
+		{iv(-inf, inf), false},
+		{iv(-inf, -1), false},
+		{iv(2, 1), true},
+		{iv(inf, inf), true},
+		{iv(-inf, -inf), true},
+		{iv(math.NaN(), 1), true},
+		{iv(1, math.NaN()), true},
+		{EmptyInterval(), true},
+		{EntireInterval(), false},
+		{PointInterval(Pi), false},
+		{PointInterval(NaN()), true},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := tt.arg.IsEmpty(); got != tt.want {
+				t.Errorf("%v.IsEmpty() = %v, want %v", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterval_Contains(t *testing.T) {
+	tests := []struct {
+		arg  Interval
+		n    Number
+		want bool
+	}{
+		{iv(1, 2), Float(1), true},
+		{iv(1, 2), Float(2), true},
+		{iv(1, 2), Number{2, 0x1p-60}, false},
+		{iv(1, 2), Number{1, -0x1p-60}, false},
+		{iv(-zero, 0), Float(-zero), true},
+		{EntireInterval(), Float(math.MaxFloat64), true},
+		{EntireInterval(), NaN(), false},
+		{EmptyInterval(), Float(0), false},
+		{iv(2, 1), Float(1.5), false},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := tt.arg.Contains(tt.n); got != tt.want {
+				t.Errorf("%v.Contains(%v) = %v, want %v", tt.arg, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterval_sets(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		a, b      Interval
+		intersect Interval
+		hull      Interval
+	}{
+		{iv(1, 3), iv(2, 4), iv(2, 3), iv(1, 4)},
+		{iv(1, 2), iv(3, 4), EmptyInterval(), iv(1, 4)},
+		{iv(1, 2), iv(2, 4), iv(2, 2), iv(1, 4)},
+		{iv(-inf, 0), iv(-1, inf), iv(-1, 0), EntireInterval()},
+		{iv(1, 2), EmptyInterval(), EmptyInterval(), iv(1, 2)},
+		{iv(2, 1), iv(3, 4), EmptyInterval(), iv(3, 4)},
+		{EmptyInterval(), iv(math.NaN(), 0), EmptyInterval(), EmptyInterval()},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := tt.a.Intersect(tt.b); !sameInterval(got, tt.intersect) {
+				t.Errorf("%v.Intersect(%v) = %v, want %v", tt.a, tt.b, got, tt.intersect)
+			}
+			if got := tt.a.Hull(tt.b); !sameInterval(got, tt.hull) {
+				t.Errorf("%v.Hull(%v) = %v, want %v", tt.a, tt.b, got, tt.hull)
+			}
+		})
+	}
+}
+
+func TestInterval_arith(t *testing.T) {
+	inf := math.Inf(1)
+	third := Div(Float(1), Float(3))
+	tests := []struct {
+		name string
+		got  Interval
+		want Interval
+	}{
+		{"[1,2]+[3,4]", iv(1, 2).Add(iv(3, 4)), iv(4, 6)},
+		{"[1,2]-[3,4]", iv(1, 2).Sub(iv(3, 4)), iv(-3, -1)},
+		{"[-inf,1]+[1,inf]", iv(-inf, 1).Add(iv(1, inf)), EntireInterval()},
+		{"[1,2]+∅", iv(1, 2).Add(EmptyInterval()), EmptyInterval()},
+		{"-[1,2]", iv(1, 2).Neg(), iv(-2, -1)},
+		{"[1,2]*[3,4]", iv(1, 2).Mul(iv(3, 4)), iv(3, 8)},
+		{"[-1,2]*[3,4]", iv(-1, 2).Mul(iv(3, 4)), iv(-4, 8)},
+		{"[-1,2]*[-3,4]", iv(-1, 2).Mul(iv(-3, 4)), iv(-6, 8)},
+		{"[-2,-1]*[-4,-3]", iv(-2, -1).Mul(iv(-4, -3)), iv(3, 8)},
+		{"[0,0]*[-inf,inf]", iv(0, 0).Mul(EntireInterval()), iv(0, 0)},
+		{"[0,1]*[1,inf]", iv(0, 1).Mul(iv(1, inf)), iv(0, inf)},
+		{"[-1,0]*[1,inf]", iv(-1, 0).Mul(iv(1, inf)), iv(-inf, 0)},
+		{"[1,2]*∅", iv(1, 2).Mul(EmptyInterval()), EmptyInterval()},
+		{"[1,1]/[3,3]", iv(1, 1).Div(iv(3, 3)), Interval{third, Number{third.y, math.Nextafter(third.x, 1)}}},
+		{"[1,2]/[4,8]", iv(1, 2).Div(iv(4, 8)), iv(0.125, 0.5)},
+		{"[-1,2]/[4,8]", iv(-1, 2).Div(iv(4, 8)), iv(-0.25, 0.5)},
+		{"[-2,-1]/[4,8]", iv(-2, -1).Div(iv(4, 8)), iv(-0.5, -0.125)},
+		{"[1,2]/[-8,-4]", iv(1, 2).Div(iv(-8, -4)), iv(-0.5, -0.125)},
+		{"[-1,2]/[-8,-4]", iv(-1, 2).Div(iv(-8, -4)), iv(-0.5, 0.25)},
+		{"[-2,-1]/[-8,-4]", iv(-2, -1).Div(iv(-8, -4)), iv(0.125, 0.5)},
+		{"[1,inf]/[1,inf]", iv(1, inf).Div(iv(1, inf)), iv(0, inf)},
+		{"[1,2]/[0,0]", iv(1, 2).Div(iv(0, 0)), EmptyInterval()},
+		{"[1,2]/[-1,1]", iv(1, 2).Div(iv(-1, 1)), EntireInterval()},
+		{"[-1,2]/[1,4]", iv(-1, 2).Div(iv(1, 4)), iv(-1, 2)},
+		{"[-1,2]/[0,4]", iv(-1, 2).Div(iv(0, 4)), EntireInterval()},
+		{"[1,2]/[0,4]", iv(1, 2).Div(iv(0, 4)), iv(0.25, inf)},
+		{"[-2,-1]/[0,4]", iv(-2, -1).Div(iv(0, 4)), iv(-inf, -0.25)},
+		{"[1,2]/[-4,0]", iv(1, 2).Div(iv(-4, 0)), iv(-inf, -0.25)},
+		{"[-2,-1]/[-4,0]", iv(-2, -1).Div(iv(-4, 0)), iv(0.25, inf)},
+		{"sqrt([4,9])", iv(4, 9).Sqrt(), iv(2, 3)},
+		{"sqrt([-4,9])", iv(-4, 9).Sqrt(), iv(0, 3)},
+		{"sqrt([-4,-1])", iv(-4, -1).Sqrt(), EmptyInterval()},
+		{"sqrt([0,inf])", iv(0, inf).Sqrt(), iv(0, inf)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !sameInterval(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterval_elementary(t *testing.T) {
+	inf := math.Inf(1)
+	tests := []struct {
+		name     string
+		got      Interval
+		contains []Number
+		want     Interval // the exact result, if contains is nil
+	}{
+		{"exp([0,0])", iv(0, 0).Exp(), nil, iv(1, 1)},
+		{"exp([-inf,inf])", EntireInterval().Exp(), nil, iv(0, inf)},
+		{"exp([-inf,-1000])", iv(-inf, -1000).Exp(), []Number{{}}, Interval{}},
+		{"exp([1000,inf])", iv(1000, inf).Exp(), nil, Interval{Number{math.MaxFloat64, 0x1p970 - 0x1p917}, Inf(+1)}},
+		{"exp([0,1])", iv(0, 1).Exp(), []Number{Float(1), E}, Interval{}},
+		{"exp([-1,0])", iv(-1, 0).Exp(), []Number{Inv(E), Float(1)}, Interval{}},
+		{"log([1,1])", iv(1, 1).Log(), nil, iv(0, 0)},
+		{"log([0,inf])", iv(0, inf).Log(), nil, EntireInterval()},
+		{"log([-1,0])", iv(-1, 0).Log(), nil, EmptyInterval()},
+		{"log([-2,-1])", iv(-2, -1).Log(), nil, EmptyInterval()},
+		{"log([1,e])", Interval{Float(1), E}.Log(), []Number{Float(0), Float(1)}, Interval{}},
+		{"log([2,10])", iv(2, 10).Log(), []Number{Ln2, Ln10}, Interval{}},
+		{"sin([0,0])", iv(0, 0).Sin(), nil, iv(0, 0)},
+		{"sin([0,π])", Interval{Float(0), Pi}.Sin(), []Number{Float(0), Float(1)}, Interval{}},
+		{"sin([π/2,π/2])", PointInterval(Ldexp(Pi, -1)).Sin(), []Number{Float(1)}, Interval{}},
+		{"sin([-inf,0])", iv(-inf, 0).Sin(), nil, iv(-1, 1)},
+		{"sin([0,7])", iv(0, 7).Sin(), nil, iv(-1, 1)},
+		{"sin([1e30,1e30])", iv(1e30, 1e30).Sin(), []Number{Sin(Float(1e30))}, Interval{}},
+		{"cos([0,0])", iv(0, 0).Cos(), nil, iv(1, 1)},
+		{"cos([π,π])", PointInterval(Pi).Cos(), []Number{Float(-1)}, Interval{}},
+		{"cos([1,2])", iv(1, 2).Cos(), []Number{Cos(Float(1)), Cos(Float(2))}, Interval{}},
+		{"cos([-1,1])", iv(-1, 1).Cos(), []Number{Cos(Float(1)), Float(1)}, Interval{}},
+		{"cos([3,4])", iv(3, 4).Cos(), []Number{Float(-1), Cos(Float(4))}, Interval{}},
+		{"cos(∅)", EmptyInterval().Cos(), nil, EmptyInterval()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.contains == nil && !sameInterval(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+			for _, n := range tt.contains {
+				if !tt.got.Contains(n) {
+					t.Errorf("got %v, doesn't contain %v", tt.got, n)
+				}
+			}
+		})
+	}
+}
+
+func TestInterval_big(t *testing.T) {
+	const prec = 400
+	pi := bigPi(prec)
+	r := rand.New(rand.NewSource(1))
+	random := func() Interval {
+		a := Make(math.Ldexp(r.Float64()-0.5, r.Intn(40)-20), math.Ldexp(r.Float64()-0.5, r.Intn(40)-90))
+		if r.Intn(4) == 0 {
+			return PointInterval(a)
+		}
+		b := AddFloat(a, math.Ldexp(r.Float64(), r.Intn(40)-30))
+		return Interval{a, b}
+	}
+	// member returns a random member of i.
+	member := func(i Interval) Number {
+		switch r.Intn(3) {
+		case 0:
+			return i.Lo
+		case 1:
+			return i.Hi
+		}
+		return Add(i.Lo, Mul(Float(r.Float64()), Sub(i.Hi, i.Lo)))
+	}
+	contains := func(i Interval, want *big.Rat) bool {
+		return i.Lo.Rat().Cmp(want) <= 0 && want.Cmp(i.Hi.Rat()) <= 0
+	}
+	encloses := func(i Interval, want *big.Float) bool {
+		return i.Lo.BigFloat(nil).Cmp(want) <= 0 && want.Cmp(i.Hi.BigFloat(nil)) <= 0
+	}
+
+	for range 2000 {
+		i, j := random(), random()
+		a, b := member(i), member(j)
+		if !i.Contains(a) || !j.Contains(b) {
+			continue
+		}
+		ra, rb := a.Rat(), b.Rat()
+		var want big.Rat
+
+		if got := i.Add(j); !contains(got, want.Add(ra, rb)) {
+			t.Fatalf("%v.Add(%v) = %v, doesn't contain %v+%v", i, j, got, a, b)
+		}
+		if got := i.Sub(j); !contains(got, want.Sub(ra, rb)) {
+			t.Fatalf("%v.Sub(%v) = %v, doesn't contain %v-%v", i, j, got, a, b)
+		}
+		if got := i.Mul(j); !contains(got, want.Mul(ra, rb)) {
+			t.Fatalf("%v.Mul(%v) = %v, doesn't contain %v*%v", i, j, got, a, b)
+		}
+		if got := i.Div(j); b.y != 0 && !IsInf(got.Lo, -1) && !IsInf(got.Hi, +1) && !contains(got, want.Quo(ra, rb)) {
+			t.Fatalf("%v.Div(%v) = %v, doesn't contain %v/%v", i, j, got, a, b)
+		}
+		if got := i.Sqrt(); a.y >= 0 && !got.Contains(Sqrt(a)) {
+			t.Fatalf("%v.Sqrt() = %v, doesn't contain √%v", i, got, a)
+		}
+		x := a.BigFloat(new(big.Float).SetPrec(prec))
+		exp, _ := bigExp(x, prec)
+		if got := i.Exp(); !encloses(got, exp) {
+			t.Fatalf("%v.Exp() = %v, doesn't contain exp(%v)", i, got, a)
+		}
+		if got := i.Log(); a.y > 0 && !encloses(got, bigLog(x, prec)) {
+			t.Fatalf("%v.Log() = %v, doesn't contain log(%v)", i, got, a)
+		}
+		sin, cos := bigSincos(x, pi)
+		if got := i.Sin(); !encloses(got, sin) {
+			t.Fatalf("%v.Sin() = %v, doesn't contain sin(%v)", i, got, a)
+		}
+		if got := i.Cos(); !encloses(got, cos) {
+			t.Fatalf("%v.Cos() = %v, doesn't contain cos(%v)", i, got, a)
+		}
+
+		// The enclosures of points are tight.
+		if i.Lo == i.Hi {
+			tol := 0x1p-90 * (1 + math.Abs(a.y))
+			for name, got := range map[string]Interval{"Exp": i.Exp(), "Log": i.Log(), "Sin": i.Sin(), "Cos": i.Cos()} {
+				if w := Sub(got.Hi, got.Lo); !got.IsEmpty() && w.y > tol*math.Max(1, math.Abs(got.Hi.y)) {
+					t.Fatalf("%v.%s() = %v, not tight", i, name, got)
+				}
+			}
+		}
+	}
+}
+
+func TestInterval_tight(t *testing.T) {
+	// The enclosures of points are tight relative to the result,
+	// even near the zeros of the functions.
+	tests := []struct {
+		name string
+		got  Interval
+	}{
+		{"exp(1e-40)", PointInterval(Float(1e-40)).Exp()},
+		{"exp(-600)", PointInterval(Float(-600)).Exp()},
+		{"log(1+1e-40)", PointInterval(Number{1, 1e-40}).Log()},
+		{"log(1-1e-40)", PointInterval(Number{1, -1e-40}).Log()},
+		{"log(1e300)", PointInterval(Float(1e300)).Log()},
+		{"sin(1e-40)", PointInterval(Float(1e-40)).Sin()},
+		{"sin(-1e-200)", PointInterval(Float(-1e-200)).Sin()},
+		{"sin(π)", PointInterval(Pi).Sin()},
+		{"sin(1e300)", PointInterval(Float(1e300)).Sin()},
+		{"cos(π/2)", PointInterval(halfPi).Cos()},
+		{"cos(1e-40)", PointInterval(Float(1e-40)).Cos()},
+		{"cos(1e300)", PointInterval(Float(1e300)).Cos()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := Sub(tt.got.Hi, tt.got.Lo)
+			if tt.got.IsEmpty() || w.y > math.Abs(tt.got.Hi.y)*0x1p-96 {
+				t.Errorf("got %v, not tight", tt.got)
+			}
+		})
+	}
+}
+
+func TestInterval_tables(t *testing.T) {
+	const prec = 600
+
+	// check that |f₁ + f₂ + … - want| ≤ err.
+	check := func(name string, want *big.Float, err float64, f ...float64) {
+		t.Helper()
+		var d big.Float
+		d.SetPrec(prec).Neg(want)
+		for _, f := range f {
+			d.Add(&d, big.NewFloat(f))
+		}
+		if d.Abs(&d).Cmp(big.NewFloat(err)) > 0 {
+			t.Errorf("%s is off by %v, want at most %g", name, d.Text('g', 10), err)
+		}
+	}
+
+	var x big.Float
+	ln2 := bigLog(x.SetPrec(prec).SetInt64(2), prec)
+	check("ln2", ln2, 0x1p-160, ln2Hi, ln2Mid, ln2Lo)
+	check("ln2By64", x.SetPrec(prec).Quo(ln2, big.NewFloat(64)), 0x1p-152, ln2By64Hi, ln2By64Mid, ln2By64Lo)
+	check("halfPi", x.SetPrec(prec).Quo(bigPi(prec), big.NewFloat(2)), 0x1p-216, halfPi0, halfPi1, halfPi2, halfPi3)
+
+	for i, e := range exp2Table {
+		x.SetPrec(prec).Mul(ln2, big.NewFloat(float64(i)/64))
+		exp, _ := bigExp(&x, prec)
+		check("exp2Table", exp, 0x1p-105, e.y, e.x)
+	}
+	for _, e := range logTable {
+		log := bigLog(x.SetPrec(prec).SetFloat64(e.r), prec)
+		check("logTable", log.Neg(log), 0x1p-160, e.log.y, e.log.x, e.lo)
+	}
+}
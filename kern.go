@@ -5,7 +5,7 @@ import "math"
 func twoSumQuick(x, y float64) Number {
 	// log₂|x| ≥ log₂|y|
 	r := float64(x + y)
-	e := y - float64(r-x)
+	e := y + float64(x-r) // never -0
 	return Number{r, e}
 }
 
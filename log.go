@@ -9,76 +9,463 @@ func Log(n Number) Number {
 		return NaN()
 	case n.y == 0:
 		return Inf(-1)
-	case n.y == 1:
-		return Number{y: math.Log1p(n.x)}
 	case !isFinite(n.y):
 		return n
-	case n.y > 1:
-		return Neg(Log(Inv(n)))
 	}
 
-	// Reduce the argument until it is less than 2⁻⁵⁵.
-	var halvings int8
-	for n.y > 0x1p-55 {
-		n = Sqr(n) // log(n) = log(n²)/2
-		halvings++
+	// n = m⋅2ᵏ, with m in [¾, 1½).
+	m, k := Frexp(n)
+	if m.y < 0.75 {
+		m = shift(m, 1)
+		k--
 	}
-
-	// For n<2⁻⁵⁵ this is accurate to 107 bits:
-	// log(1/n) ≈ π / 2⋅AGM(1, 4⋅n)
-	n = Div(halfPi, agm(Float(1), shift(n, 2)))
-	return Neg(shift(n, -halvings)) // log(n) = -log(1/n)
+	l, _ := logReduced(twoSum(m.y-1, m.x), k, false)
+	return l
 }
 
 // Exp returns eⁿ, the base-e exponential of n (approximate).
 func Exp(n Number) Number {
-	y := math.Exp(n.y)
-	if y == 0 || !isFinite(y) {
-		return Number{y: y}
+	switch {
+	case IsNaN(n):
+		return n
+	case n.y > 710:
+		return Inf(+1)
+	case n.y < -746:
+		return Number{}
 	}
-	// Newton's method: y + y⋅(n-log(y))
-	t := Sub(n, Log(Float(y)))
-	return twoSumQuick(y, y*t.y)
+	x, j := expReduced(n, 0)
+	return Ldexp(x, j)
 }
 
 // Log1p returns the natural logarithm of 1 plus n (approximate).
 // It is more accurate than Log(AddFloat(n, 1)) when n is near zero.
 func Log1p(n Number) Number {
-	// https://www.johndcook.com/blog/2012/07/25/trick-for-computing-log1x/
 	u := AddFloat(n, 1)
 	switch {
 	case u.y < 0:
 		return NaN()
 	case u == Float(1) || !isFinite(u.y):
 		return n
+	case -0.25 <= n.y && n.y < 0.5:
+		l, _ := logReduced(n, 0, false)
+		return l
 	}
-	// log(1+n) = n⋅log(u)/(u-1), u=1+n
-	return Mul(n, Div(Log(u), AddFloat(u, -1)))
+	return Log(u)
 }
 
 // Expm1 returns eⁿ-1, the base-e exponential of n minus 1 (approximate).
 // It is more accurate than SubFloat(Exp(n), 1) when n is near zero.
 func Expm1(n Number) Number {
-	y := math.Expm1(n.y)
 	switch {
-	case y == 0 || !isFinite(y):
-		return Number{y: y}
-	case y == -1:
-		return SubFloats(math.Exp(n.y), 1)
+	case n.y == 0 || IsNaN(n):
+		return n
+	case n.y > 710:
+		return Inf(+1)
+	case n.y < -40:
+		return AddFloat(Exp(n), -1)
+	case math.Abs(n.y) < 0.25:
+		// Halve the argument until it is less than 2⁻⁸,
+		// then double it back: e²ⁿ-1 = (eⁿ-1)⋅(eⁿ-1 + 2).
+		var halvings int8
+		if _, e := math.Frexp(n.y); e > -8 {
+			halvings = int8(e + 8)
+		}
+		y := expm1Poly(shift(n, -halvings))
+		for range halvings {
+			y = Mul(y, AddFloat(y, 2))
+		}
+		return y
 	}
-	// Newton's method: y + (y+1)⋅(n-log1p(y))
-	t := Sub(n, Log1p(Float(y)))
-	return twoSumQuick(y, (y+1)*t.y)
+
+	// eⁿ-1 = (x - 2⁻ʲ)⋅2ʲ
+	x, j := expReduced(n, 0)
+	return Ldexp(AddFloat(x, -math.Ldexp(1, -j)), j)
 }
 
-func agm(a, g Number) Number {
-	// https://en.wikipedia.org/wiki/Arithmetic–geometric_mean
-	for {
-		t := shift(Add(a, g), -1)
-		if t == a {
-			return a
+// Log2 returns the binary logarithm of n (approximate).
+// It's exact if n is a power of two.
+func Log2(n Number) Number {
+	switch {
+	case n.y < 0:
+		return NaN()
+	case n.y == 0:
+		return Inf(-1)
+	case !isFinite(n.y):
+		return n
+	}
+
+	// n = m⋅2ᵏ, with m in [√½, √2).
+	m, k := Frexp(n)
+	if m == Float(0.5) {
+		return Float(float64(k - 1))
+	}
+	if m.y < math.Sqrt2/2 {
+		m = shift(m, 1)
+		k--
+	}
+
+	// log₂(n) = k + log(m)⋅log₂(e)
+	l, ll := logExt(m)
+	l, ll = mulExt(l, ll, Number{log2EHi, log2EMid}, log2ELo)
+	var e expansion
+	e.add(float64(k))
+	e.add(l.y)
+	e.add(l.x)
+	e.add(ll)
+	r, _ := e.round()
+	return r
+}
+
+// Log10 returns the decimal logarithm of n (approximate).
+// It's exact if n is a power of ten.
+func Log10(n Number) Number {
+	switch {
+	case n.y < 0:
+		return NaN()
+	case n.y == 0:
+		return Inf(-1)
+	case !isFinite(n.y):
+		return n
+	}
+
+	// log₁₀(n) = log(n)⋅log₁₀(e)
+	l, ll := logExt(n)
+	r, _ := mulExt(l, ll, Number{log10EHi, log10EMid}, log10ELo)
+
+	// Only the nonnegative powers of ten are exact, up to 10³⁰⁸.
+	if j := math.Round(r.y); 0 <= j && j <= 308 && math.Abs(r.y-j) < 0x1p-40 {
+		if Pow10(int(j)) == n {
+			return Float(j)
 		}
-		g = Sqrt(Mul(a, g))
-		a = t
 	}
+	return r
+}
+
+// Exp2 returns 2ⁿ, the base-2 exponential of n (approximate).
+// It's exact if n is an integer, and the result is representable.
+func Exp2(n Number) Number {
+	switch {
+	case IsNaN(n):
+		return n
+	case n.y > 1100:
+		return Inf(+1)
+	case n.y < -1100:
+		return Number{}
+	}
+
+	// 2ⁿ = 2ᶠ⋅2ʲ, with j an integer, and |f| ≤ ½.
+	j := Round(n)
+	f := Sub(n, j) // exact
+	if f.y == 0 {
+		return Ldexp(Float(1), int(j.y))
+	}
+	x := expExt(mulExt(f, 0, Number{ln2Hi, ln2Mid}, ln2Lo))
+	return Ldexp(x, int(j.y))
+}
+
+// Exp10 returns 10ⁿ, the base-10 exponential of n (approximate).
+// It's exact if n is an integer, and the result is representable.
+func Exp10(n Number) Number {
+	switch {
+	case IsNaN(n):
+		return n
+	case n.y > 400:
+		return Inf(+1)
+	case n.y < -400:
+		return Number{}
+	case Round(n) == n:
+		return Pow10(int(n.y))
+	}
+	return expExt(mulExt(n, 0, Number{ln10Hi, ln10Mid}, ln10Lo))
+}
+
+// The logarithms of e and 10, in extended precision.
+const (
+	log2EHi  = 1.4426950408889634
+	log2EMid = 0x1.777d0ffda0d24p-56
+	log2ELo  = -0x1.60bb8a5442ab9p-110
+
+	log10EHi  = 0.4342944819032518
+	log10EMid = 0x1.95355baaafad3p-57
+	log10ELo  = 0x1.ee191f71a3012p-112
+
+	ln10Hi  = 2.302585092994046
+	ln10Mid = -0x1.f48ad494ea3e9p-53
+	ln10Lo  = -0x1.9ebae3ae0260cp-107
+)
+
+// log(2)/64 split in three, with the high part in 36 bits.
+const (
+	ln2By64Hi  = 0x1.62e42fefap-07
+	ln2By64Mid = 0x1.cf79abc9e3b3ap-46
+	ln2By64Lo  = -0x1.ff0342542fc33p-100
+)
+
+// expReduced returns x and j, such that eⁿ⁺ⁿˡ = x⋅2ʲ,
+// with x near [1, 2), for |n| ≤ 746.
+func expReduced(n Number, nl float64) (Number, int) {
+	// n = k⋅log(2)/64 + r, with |r| ≤ log(2)/128.
+	// Since |k| < 2¹⁷, k⋅ln2By64Hi and n.y - k⋅ln2By64Hi are exact,
+	// and n.x may be as large as the ulp of r.y, so it's added exactly.
+	k := math.Round(n.y * (64 / math.Ln2))
+	p := twoProd(k, ln2By64Mid)
+	r := Add(twoSum(n.y-k*ln2By64Hi, -p.y), twoSum(n.x, -p.x))
+	r = AddFloat(r, nl-k*ln2By64Lo)
+
+	// eⁿ = 2ʲ⋅2ⁱᐟ⁶⁴⋅eʳ, with k = 64⋅j + i.
+	t := exp2Table[int(k)&63]
+	return Add(t, Mul(t, expm1Poly(r))), int(k) >> 6
+}
+
+// expm1Poly returns eʳ-1, for |r| ≤ log(2)/128.
+func expm1Poly(r Number) Number {
+	// eʳ-1 = r + r²/2! + r³/3! + … + r¹¹/11!, and the rest of the series
+	// is less than 2⁻¹¹⁸; terms beyond r⁶ only need float64 precision.
+	t := r.y * (1.0/5040 + r.y*(1.0/40320+r.y*(1.0/362880+r.y*(1.0/3628800+r.y*(1.0/39916800)))))
+	p := AddFloat(Number{1.0 / 720, -0x1.f49f49f49f49fp-65}, t)
+	p = Add(Number{1.0 / 120, +0x1.1111111111111p-63}, Mul(r, p))
+	p = Add(Number{1.0 / 24, +0x1.5555555555555p-59}, Mul(r, p))
+	p = Add(Number{1.0 / 6, +0x1.5555555555555p-57}, Mul(r, p))
+	p = AddFloat(Mul(r, p), 0.5)
+	return Add(r, Mul(Sqr(r), p))
+}
+
+// logReduced returns log(1+f) + k⋅log(2), for 1+f in [¾, 1½].
+// If ext is set, the result is in extended precision,
+// otherwise the remainder is zero.
+func logReduced(f Number, k int, ext bool) (Number, float64) {
+	// 1+f = (1+t)/r, with r from a table, and |t| < 2⁻⁷·⁵.
+	// t = (r-1) + f⋅r is computed exactly, as h + c, then rounded.
+	e := &logTable[int(math.Round(f.y*128))+32]
+	a := twoProd(f.y, e.r)
+	b := twoProd(f.x, e.r)
+	c := twoSum(a.x, b.y)
+	c.x += b.x
+	h := twoSum(e.r-1, a.y)
+
+	// log(1+t) = 2⋅atanh(s) = 2⋅(s + s³/3 + s⁵/5 + … + s¹³/13),
+	// with s = t/(2+t), and |s| < 2⁻⁸·⁵; the rest of the series is
+	// less than 2⁻¹¹⁸⋅s, and terms beyond s⁷ only need float64 precision.
+	// In extended precision, only t and s need more than double-double.
+	var t, s Number
+	var tl, sl float64
+	if ext {
+		var x expansion
+		x.add(h.y)
+		x.add(h.x)
+		x.add(c.y)
+		x.add(c.x)
+		t, tl = x.round()
+		x = expansion{}
+		x.add(2)
+		x.add(t.y)
+		x.add(t.x)
+		x.add(tl)
+		g, gl := x.round()
+		s, sl = divExt(t, tl, g, gl)
+	} else {
+		t = Add(h, c)
+		s = Div(t, AddFloat(t, 2))
+	}
+	z := Sqr(s)
+	u := z.y * (1.0/9 + z.y*(1.0/11+z.y*(1.0/13)))
+	q := AddFloat(Number{1.0 / 7, +0x1.2492492492492p-57}, u)
+	q = Add(Number{1.0 / 5, -0x1.999999999999ap-57}, Mul(z, q))
+	q = Add(Number{1.0 / 3, +0x1.5555555555555p-56}, Mul(z, q))
+	q = shift(Mul(s, Mul(z, q)), 1)
+
+	// log(1+f) = log(1+t) - log(r)
+	if ext {
+		var x expansion
+		x.mulAdd(float64(k), ln2Hi)
+		x.mulAdd(float64(k), ln2Mid)
+		x.add(float64(k) * ln2Lo)
+		x.add(e.log.y)
+		x.add(e.log.x)
+		x.add(e.lo)
+		x.add(2 * s.y)
+		x.add(2 * s.x)
+		x.add(2 * sl)
+		x.add(q.y)
+		x.add(q.x)
+		return x.round()
+	}
+	l := Add(shift(s, 1), q)
+	kl := twoProd(float64(k), ln2Hi)
+	kl.x += float64(k)*ln2Mid + float64(k)*ln2Lo
+	return AddFloat(Add(Add(kl, e.log), l), e.lo), 0
+}
+
+// exp2Table holds 2ⁱᐟ⁶⁴, for i in [0, 64).
+var exp2Table = [64]Number{
+	{1, 0},
+	{1.0108892860517005, -0x1.19083535b085dp-56},
+	{1.0218971486541166, +0x1.d73e2a475b465p-55},
+	{1.0330248790212284, +0x1.186be4bb284ffp-57},
+	{1.0442737824274138, +0x1.8a62e4adc610bp-54},
+	{1.0556451783605572, +0x1.03a1727c57b53p-59},
+	{1.0671404006768237, -0x1.6c51039449b3ap-54},
+	{1.0787607977571199, -0x1.32fbf9af1369ep-54},
+	{1.0905077326652577, -0x1.19041b9d78a76p-55},
+	{1.102382583307841, +0x1.e5b4c7b4968e4p-55},
+	{1.1143867425958924, +0x1.e016e00a2643cp-54},
+	{1.1265216186082418, +0x1.dc775814a8495p-55},
+	{1.1387886347566916, +0x1.9b07eb6c70573p-54},
+	{1.1511892299529827, +0x1.2bd339940e9d9p-55},
+	{1.1637248587775775, +0x1.612e8afad1255p-55},
+	{1.1763969916502812, +0x1.0024754db41d5p-54},
+	{1.189207115002721, +0x1.6f46ad23182e4p-55},
+	{1.202156731452703, +0x1.32721843659a6p-54},
+	{1.215247359980469, -0x1.63aeabf42eae2p-54},
+	{1.22848053610687, -0x1.5e436d661f5e3p-56},
+	{1.241857812073484, +0x1.ada0911f09ebcp-55},
+	{1.255380757024691, -0x1.ef3691c309278p-58},
+	{1.2690509571917332, +0x1.89b7a04ef80dp-59},
+	{1.2828700160787783, +0x1.3c1a3b69062fp-56},
+	{1.2968395546510096, +0x1.d4397afec42e2p-56},
+	{1.3109612115247644, -0x1.4b309d25957e3p-54},
+	{1.3252366431597413, -0x1.07abe1db13cadp-55},
+	{1.339667524053303, +0x1.9bb2c011d93adp-54},
+	{1.3542555469368927, +0x1.6324c054647adp-54},
+	{1.3690024229745905, +0x1.ba6f93080e65ep-54},
+	{1.383909881963832, -0x1.383c17e40b497p-54},
+	{1.3989796725383112, -0x1.bb60987591c34p-54},
+	{1.4142135623730951, -0x1.bdd3413b26456p-54},
+	{1.42961333839197, -0x1.bbe3a683c88abp-57},
+	{1.4451808069770467, -0x1.16e4786887a99p-55},
+	{1.460917794180647, -0x1.0245957316dd3p-54},
+	{1.4768261459394993, -0x1.41577ee04992fp-55},
+	{1.4929077282912648, +0x1.05d02ba15797ep-56},
+	{1.5091644275934228, -0x1.d4c1dd41532d8p-54},
+	{1.5255981507445384, -0x1.fc6f89bd4f6bap-54},
+	{1.5422108254079407, +0x1.6e9f156864b27p-54},
+	{1.559004400237837, +0x1.5cc13a2e3976cp-55},
+	{1.5759808451078865, -0x1.75fc781b57ebcp-57},
+	{1.593142151342267, -0x1.d185b7c1b85d1p-54},
+	{1.6104903319492543, +0x1.c7c46b071f2bep-56},
+	{1.6280274218573478, -0x1.359495d1cd533p-54},
+	{1.645755478153965, -0x1.d2f6edb8d41e1p-54},
+	{1.6636765803267364, +0x1.0fac90ef7fd31p-54},
+	{1.681792830507429, +0x1.7a1cd345dcc81p-54},
+	{1.7001063537185235, -0x1.2805e3084d708p-57},
+	{1.718619298122478, -0x1.5584f7e54ac3bp-56},
+	{1.7373338352737062, +0x1.23dd07a2d9e84p-55},
+	{1.7562521603732995, +0x1.11065895048ddp-55},
+	{1.7753764925265212, +0x1.2884dff483cadp-54},
+	{1.7947090750031072, +0x1.503cbd1e949dbp-56},
+	{1.8142521755003989, -0x1.cbc3743797a9cp-54},
+	{1.8340080864093424, +0x1.2ed02d75b3707p-55},
+	{1.8539791250833855, +0x1.c2300696db532p-54},
+	{1.8741676341103, -0x1.1a5cd4f184b5cp-54},
+	{1.8945759815869656, +0x1.39e8980a9cc8fp-55},
+	{1.9152065613971474, -0x1.e9c23179c2893p-54},
+	{1.9360617934922943, +0x1.dc7f486a4b6bp-54},
+	{1.9571441241754002, +0x1.9d3e12dd8a18bp-54},
+	{1.978456026387951, +0x1.74853f3a5931ep-55},
+}
+
+// logTable holds, for i in [96, 192], r = 128/i rounded,
+// and -log(r) in triple precision: log + lo.
+var logTable = [97]struct {
+	r   float64
+	log Number
+	lo  float64
+}{
+	{1.3333333333333333, Number{-0.28768207245178085, -0x1.e0efadd9db02ap-56}, -0x1.385461e921b99p-111},
+	{1.3195876288659794, Number{-0.27731928541623435, +0x1.e9575c2124912p-56}, -0x1.2233884a954p-110},
+	{1.3061224489795917, Number{-0.26706278524904514, -0x1.b8ce2d07f1cb7p-56}, +0x1.a01c44ae02789p-110},
+	{1.292929292929293, Number{-0.2569104137850273, +0x1.24e912b16ec8bp-60}, -0x1.df429aec44d38p-117},
+	{1.28, Number{-0.2468600779315258, -0x1.ecca0cdf30143p-58}, +0x1.64f89bb123836p-113},
+	{1.2673267326732673, Number{-0.23690974707835774, +0x1.f7627ef82f3fp-57}, -0x1.61924609a69b5p-111},
+	{1.2549019607843137, Number{-0.22705745063534608, +0x1.3f3adb7b71cbcp-58}, -0x1.e03fc22bd8feep-114},
+	{1.2427184466019416, Number{-0.2173012756899813, +0x1.1165504ad749ep-59}, +0x1.1555826b9dff3p-114},
+	{1.2307692307692308, Number{-0.20763936477824455, -0x1.bcafa9de97202p-57}, -0x1.ccdcee3115f1fp-111},
+	{1.2190476190476192, Number{-0.19806991376209387, -0x1.8a16283fdbd1cp-57}, +0x1.e04b19df57e3p-113},
+	{1.2075471698113207, Number{-0.18859116980754997, -0x1.6dcd318f4187ep-57}, -0x1.f481eacf6db47p-113},
+	{1.1962616822429906, Number{-0.17920142945771092, +0x1.37967087859b9p-59}, -0x1.a3ef3637e22a4p-113},
+	{1.1851851851851851, Number{-0.16989903679539742, +0x1.1f5b44c0df7f7p-61}, +0x1.25a7abe3c668p-115},
+	{1.1743119266055047, Number{-0.16068238169047352, +0x1.0d5604930f137p-58}, -0x1.046ddd0c49961p-112},
+	{1.1636363636363636, Number{-0.15154989812720088, -0x1.bea08d2dca256p-57}, +0x1.bf1efc9fe606ep-111},
+	{1.1531531531531531, Number{-0.142500062607283, -0x1.51c7e9efae297p-57}, +0x1.49309bfb61ce3p-111},
+	{1.1428571428571428, Number{-0.13353139262452257, +0x1.0e63a5f01c693p-58}, -0x1.03c776a3fb0efp-112},
+	{1.1327433628318584, Number{-0.12464244520727659, +0x1.ac9f4215f9394p-58}, -0x1.d23d841377071p-112},
+	{1.1228070175438596, Number{-0.11583181552512165, -0x1.401fa71733017p-58}, +0x1.0554118a2fe2ep-112},
+	{1.1130434782608696, Number{-0.10709813555636712, +0x1.002bf768e52dp-58}, -0x1.6a6e9bac4ae3cp-112},
+	{1.103448275862069, Number{-0.09844007281325251, +0x1.478a85704ccb7p-58}, -0x1.112e6b065fe5ep-113},
+	{1.0940170940170941, Number{-0.08985632912186114, -0x1.a36a677b4c8b2p-59}, +0x1.8747b9d920b79p-113},
+	{1.0847457627118644, Number{-0.0813456394539524, -0x1.da7d0b1e10b2fp-60}, -0x1.7d34a76de4fddp-114},
+	{1.0756302521008403, Number{-0.07290677080808773, -0x1.aea2c72d05c08p-58}, +0x1.56d15ca352247p-112},
+	{1.0666666666666667, Number{-0.06453852113757116, +0x1.dd7009902bf32p-58}, +0x1.53ed0393a700ep-112},
+	{1.0578512396694215, Number{-0.05623971832287611, +0x1.e48fb0500efd5p-59}, -0x1.ac00b6b1f34ccp-113},
+	{1.0491803278688525, Number{-0.04800921918636066, +0x1.2ba0b44cfaee5p-59}, +0x1.0afcb9f93ac8bp-114},
+	{1.0406504065040652, Number{-0.03984590854719978, +0x1.9badefe942718p-60}, +0x1.ab4be430070f9p-115},
+	{1.032258064516129, Number{-0.03174869831458027, -0x1.c05cf1d753621p-59}, -0x1.3bc1c184cef09p-114},
+	{1.024, Number{-0.023716526617316065, +0x1.d192d0619fa68p-60}, -0x1.1dbd58307947dp-117},
+	{1.0158730158730158, Number{-0.015748356968139112, -0x1.27c8e8416e717p-60}, +0x1.19642aac13124p-116},
+	{1.0078740157480315, Number{-0.007843177461025879, -0x1.46662d417cecep-62}, -0x1.e91702f8418aap-120},
+	{1, Number{}, 0},
+	{0.9922480620155039, Number{0.007782140442054963, -0x1.e44b7e3711e7fp-67}, +0x1.a567b6587df3fp-121},
+	{0.9846153846153847, Number{0.015504186535965199, -0x1.83092c5964281p-62}, -0x1.52414fc416fd7p-116},
+	{0.9770992366412213, Number{0.023167059281534418, -0x1.6d80ab38e943p-62}, -0x1.032b0efd5adc5p-118},
+	{0.9696969696969697, Number{0.03077165866675366, +0x1.33e3f04f1ef25p-60}, -0x1.814544147acc9p-114},
+	{0.9624060150375939, Number{0.03831886430213666, -0x1.5bfa937f551b7p-59}, +0x1.c8d57ae1e11c3p-114},
+	{0.9552238805970149, Number{0.04580953603129422, +0x1.8d3ca87b92968p-63}, +0x1.07937ee036553p-117},
+	{0.9481481481481482, Number{0.05324451451881224, +0x1.0a34531f67db5p-59}, +0x1.629579c4c681fp-113},
+	{0.9411764705882353, Number{0.060624621816434854, +0x1.85f325c5bbacdp-59}, -0x1.d9cb2e2cb3228p-118},
+	{0.9343065693430657, Number{0.06795066190850778, +0x1.2189705cf74cap-58}, +0x1.6cdb48520b4cep-113},
+	{0.927536231884058, Number{0.07522342123758752, -0x1.3599f227becbbp-58}, -0x1.47ef2f89ad244p-115},
+	{0.920863309352518, Number{0.08244366921107454, -0x1.5b61c65e5741ap-58}, +0x1.812f271f826edp-114},
+	{0.9142857142857143, Number{0.08961215868968717, -0x1.20db323097324p-59}, +0x1.919ca183deca2p-113},
+	{0.9078014184397163, Number{0.09672962645855114, -0x1.294d2f5668495p-58}, +0x1.96ae04c07c81bp-113},
+	{0.9014084507042254, Number{0.10379679368164355, -0x1.d7a16eab1e2adp-59}, +0x1.99a9f67e22ed2p-116},
+	{0.8951048951048951, Number{0.11081436634029011, +0x1.2eb0bf7c0b0d9p-59}, -0x1.11c4d32a0e479p-113},
+	{0.8888888888888888, Number{0.11778303565638351, -0x1.61578001e015ap-60}, +0x1.55db94ebc402dp-116},
+	{0.8827586206896552, Number{0.12470347850095725, -0x1.5746b9981b36cp-58}, -0x1.44016e1d457eep-112},
+	{0.8767123287671232, Number{0.13157635778871932, +0x1.9a5dc5e9030adp-57}, -0x1.71dbd9a581397p-111},
+	{0.8707482993197279, Number{0.1384023228591192, -0x1.fbe7ee5c69946p-57}, +0x1.0d7bc7ec84caap-111},
+	{0.8648648648648649, Number{0.14518200984449783, +0x1.301771c407dcp-57}, -0x1.977b021b7c785p-111},
+	{0.8590604026845637, Number{0.151916042025842, +0x1.e6cb62af18a02p-62}, -0x1.8fe0cd92558acp-116},
+	{0.8533333333333334, Number{0.15860503017663852, +0x1.7d3d950f87e23p-59}, +0x1.950595f322e9bp-113},
+	{0.847682119205298, Number{0.16524957289530717, -0x1.546ff8a470d3ap-57}, +0x1.a71bcc63b5444p-111},
+	{0.8421052631578947, Number{0.17185025692665928, -0x1.bc60efafc6f6cp-58}, -0x1.140655471953ep-113},
+	{0.8366013071895425, Number{0.17840765747281825, +0x1.d551d97132e87p-57}, +0x1.f2768c9609739p-112},
+	{0.8311688311688312, Number{0.18492233849401193, -0x1.1072534a57e7dp-57}, +0x1.aa47fe1494d87p-111},
+	{0.8258064516129032, Number{0.19139485299962947, -0x1.9f7fdbfa08d9ap-57}, -0x1.09daa8fb49481p-112},
+	{0.8205128205128205, Number{0.19782574332991992, -0x1.26fb3e2b1d1dap-57}, +0x1.899417da79eedp-117},
+	{0.8152866242038217, Number{0.20421554142869083, +0x1.24dc46c1ea664p-57}, -0x1.1e381c9324e9bp-112},
+	{0.810126582278481, Number{0.21056476910734964, +0x1.a3398064df33ep-57}, -0x1.e34c4b23a32d1p-111},
+	{0.8050314465408805, Number{0.2168739383006143, +0x1.cfce744870f57p-58}, -0x1.7474f08d6e4e1p-113},
+	{0.8, Number{0.2231435513142097, -0x1.4f689f8434011p-57}, +0x1.a24ae3b2f53ap-111},
+	{0.7950310559006211, Number{0.2293741010648459, -0x1.a37794d03657dp-58}, +0x1.87c6ce7a257f8p-113},
+	{0.7901234567901234, Number{0.23556607131276697, -0x1.61578001e015ep-59}, +0x1.55db94ebc4023p-115},
+	{0.7852760736196319, Number{0.24171993688714513, +0x1.e8637950dc20dp-57}, -0x1.34c52d7b3cbe3p-111},
+	{0.7804878048780488, Number{0.2478361639045812, +0x1.355519b0de535p-57}, +0x1.682480b088ab6p-113},
+	{0.7757575757575758, Number{0.25391520998096345, -0x1.08ec217a5022dp-57}, -0x1.0d9dc4cf9a1f9p-111},
+	{0.7710843373493976, Number{0.259957524436926, +0x1.bdcfde8061c03p-56}, +0x1.faa3780d6bef8p-110},
+	{0.7664670658682635, Number{0.2659635484971379, +0x1.3f415699663ecp-63}, -0x1.96634e8c81dc6p-117},
+	{0.7619047619047619, Number{0.2719337154836418, +0x1.ce63eab883727p-61}, +0x1.1f833e825228bp-119},
+	{0.757396449704142, Number{0.2778684510034563, +0x1.9f1a39d500e3cp-56}, -0x1.68223be88a50ap-111},
+	{0.7529411764705882, Number{0.2837681731306446, -0x1.dbd7ac258a2bdp-58}, +0x1.3d2e9aad37a78p-112},
+	{0.7485380116959064, Number{0.2896332925830427, +0x1.7ad24c13f040fp-56}, -0x1.3a52b8aa6834fp-111},
+	{0.7441860465116279, Number{0.2954642128938359, -0x1.1e99b72bd7bf2p-57}, -0x1.464244294826fp-111},
+	{0.7398843930635838, Number{0.30126133057816185, -0x1.16ea62c048cfbp-56}, -0x1.72b77ad3fa626p-110},
+	{0.735632183908046, Number{0.3070250352949119, +0x1.cbcd735d03424p-60}, -0x1.485c31181fd5fp-119},
+	{0.7314285714285714, Number{0.3127557100038969, -0x1.f79f6c1059cdbp-57}, +0x1.85e41827d9d92p-112},
+	{0.7272727272727273, Number{0.3184537311185346, -0x1.7a42642661c62p-61}, +0x1.05772cd24c009p-116},
+	{0.7231638418079096, Number{0.324119468654212, -0x1.4b366b609027ap-58}, +0x1.26b953458673dp-112},
+	{0.7191011235955056, Number{0.32975328637246804, -0x1.d8db0a7cc1543p-56}, -0x1.f7158586541ap-110},
+	{0.7150837988826816, Number{0.3353555419211378, -0x1.fb2a49af933e8p-57}, -0x1.4a1d1f2f339b1p-114},
+	{0.7111111111111111, Number{0.3409265869705932, -0x1.7dc9c7c23801fp-56}, +0x1.487ce02d29ad1p-110},
+	{0.7071823204419889, Number{0.3464667673462086, -0x1.090a0dd59fe35p-58}, +0x1.39c89b1577497p-112},
+	{0.7032967032967034, Number{0.3519764231571781, +0x1.710af840538e3p-56}, -0x1.75348484b8f33p-110},
+	{0.6994535519125683, Number{0.3574558889218038, -0x1.bfb1b39ca3a0fp-56}, -0x1.0fae95182c66ap-110},
+	{0.6956521739130435, Number{0.3629054936893685, +0x1.ce24c53fad3fp-58}, -0x1.a55221ef23158p-116},
+	{0.6918918918918919, Number{0.36832556115870757, +0x1.f057691fe9ed7p-56}, +0x1.59fc32ef1837bp-116},
+	{0.6881720430107527, Number{0.373716409793584, -0x1.698b43096b576p-59}, +0x1.7e2c197f97edcp-113},
+	{0.6844919786096256, Number{0.3790783529349695, +0x1.54ec519784677p-56}, -0x1.651f73d71c18dp-113},
+	{0.6808510638297872, Number{0.38441169891033206, +0x1.2d38c40881e0bp-57}, -0x1.8800739afc97fp-113},
+	{0.6772486772486772, Number{0.38971675114002524, +0x1.f85da755a61a3p-56}, +0x1.979cbfcbc0e47p-112},
+	{0.6736842105263158, Number{0.394993808240869, +0x1.1266e8a3e8838p-57}, -0x1.f9b6b19ed11afp-111},
+	{0.6701570680628273, Number{0.40024316412701266, -0x1.315b444ee1f38p-56}, -0x1.a436891c6d418p-110},
+	{0.6666666666666666, Number{0.40546510810816444, -0x1.a92e513217f58p-59}, +0x1.0c0cfa41ff66bp-113},
 }
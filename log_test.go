@@ -2,6 +2,8 @@ package dbldbl
 
 import (
 	"math"
+	"math/big"
+	"math/rand"
 	"testing"
 )
 
@@ -16,8 +18,8 @@ func TestLog(t *testing.T) {
 		{Float(3), "1.098612288668109691395245236922525704647490557822749451"}, // https://oeis.org/A002391
 		{Float(10), "2.30258509299404568401799145468436420760110148862877297"}, // https://oeis.org/A002392
 		{Float(0.5), "-0.693147180559945309417232121458176568075500134360255"},
-		{Float(math.Nextafter(1, 2)), "2.2204460492503128343282295362059e-16"},
-		{AddFloats(1, 0x1p-55), "2.77555756156289135105907917022705e-17"},
+		{Float(math.Nextafter(1, 2)), "2.220446049250312834328230454615487925982331808e-16"},
+		{AddFloats(1, 0x1p-55), "2.775557561562891312540480282482334432867697173e-17"},
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
@@ -147,7 +149,7 @@ func TestExpm1(t *testing.T) {
 		{Phi, "4.04316564336002865131188218928542471032359017541384636030200"}, // https://oeis.org/A139341
 		{Float(1), "1.718281828459045235360287471352662497757247093699959574"}, // https://oeis.org/A001113
 		{Float(2), "6.389056098930650227230427460575007813180315570551847324"}, // https://oeis.org/A072334
-		{Float(0x1p-55), "2.77555756156289135105907917022705e-17"},
+		{Float(0x1p-55), "2.775557561562891389577678057971768198738e-17"},
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
@@ -184,11 +186,366 @@ func TestExpm1_specials(t *testing.T) {
 	}
 }
 
-func Test_agm(t *testing.T) {
-	// https://en.wikipedia.org/wiki/Arithmetic%E2%80%93geometric_mean#Example
-	got := agm(Float(24), Float(6))
-	want := "13.4581714817256154207668131569743992430538388544"
-	if !near(got, want) {
-		t.Errorf("agm = %v, want %v", got, want)
+func TestExp_big(t *testing.T) {
+	const prec = 400
+	r := rand.New(rand.NewSource(1))
+
+	// check that |got - want| ≤ 2⁻¹⁰⁴⋅|want| + 2⁻¹⁰⁷³.
+	check := func(name string, got Number, want *big.Float, arg Number) {
+		t.Helper()
+		var d, e big.Float
+		d.SetPrec(prec).Sub(got.BigFloat(nil), want).Abs(&d)
+		e.SetPrec(prec).Abs(want).SetMantExp(&e, -104)
+		e.Add(&e, big.NewFloat(0x1p-1073))
+		if d.Cmp(&e) > 0 {
+			t.Fatalf("%s(%#v) = %#v, want %v", name, arg, got, want.Text('g', 40))
+		}
+	}
+
+	for i := range 2000 {
+		var n Number
+		switch i % 3 {
+		case 0:
+			n = Make(r.Float64()*1454-745, math.Ldexp(r.Float64()-0.5, -60))
+		case 1:
+			n = Make(math.Ldexp(r.Float64()-0.5, -r.Intn(60)), math.Ldexp(r.Float64()-0.5, -120))
+		case 2:
+			// A low word as large as half an ulp of the high word.
+			f := r.Float64()*1454 - 745
+			n = Make(f, f*0x1p-53*(r.Float64()-0.5))
+		}
+		exp, expm1 := bigExp(n.BigFloat(nil), prec)
+		check("Exp", Exp(n), exp, n)
+		check("Expm1", Expm1(n), expm1, n)
+	}
+}
+
+func TestLog_big(t *testing.T) {
+	const prec = 400
+	r := rand.New(rand.NewSource(1))
+
+	// check that |got - want| ≤ 2⁻¹⁰⁴⋅|want|.
+	check := func(name string, got Number, want *big.Float, arg Number) {
+		t.Helper()
+		var d, e big.Float
+		d.SetPrec(prec).Sub(got.BigFloat(nil), want).Abs(&d)
+		e.SetPrec(prec).Abs(want).SetMantExp(&e, -104)
+		if d.Cmp(&e) > 0 {
+			t.Fatalf("%s(%#v) = %#v, want %v", name, arg, got, want.Text('g', 40))
+		}
+	}
+
+	for i := range 3000 {
+		var n Number
+		switch i % 3 {
+		case 0: // the whole range
+			n = Make(math.Ldexp(1+r.Float64(), r.Intn(2098)-1074), 0)
+			if n.y > 0x1p-1000 {
+				n = Make(n.y, n.y*math.Ldexp(r.Float64()-0.5, -53))
+			}
+		case 1: // near 1
+			n = AddFloat(Make(math.Ldexp(r.Float64()-0.5, -r.Intn(100)), math.Ldexp(r.Float64()-0.5, -160)), 1)
+		case 2: // Log1p near 0, and beyond
+			m := Make(math.Ldexp(r.Float64()-0.5, -r.Intn(100)), math.Ldexp(r.Float64()-0.5, -160))
+			var x big.Float
+			x.SetPrec(prec).Add(m.BigFloat(nil), big.NewFloat(1))
+			check("Log1p", Log1p(m), bigLog(&x, prec), m)
+			n = AddFloat(Make(r.Float64()*8, 0), -0.9)
+			x.SetPrec(prec).Add(n.BigFloat(nil), big.NewFloat(1))
+			check("Log1p", Log1p(n), bigLog(&x, prec), n)
+			n = AddFloat(n, 1)
+		}
+		check("Log", Log(n), bigLog(n.BigFloat(nil), prec), n)
+	}
+}
+
+// bigExp returns eˣ and eˣ-1, to prec bits,
+// by halving x, the Taylor series, and doubling.
+func bigExp(x *big.Float, prec uint) (exp, expm1 *big.Float) {
+	s := max(0, x.MantExp(nil)+8)
+	y := new(big.Float).SetPrec(prec).SetMantExp(x, -s)
+	sum := new(big.Float).SetPrec(prec)
+	term := new(big.Float).SetPrec(prec).Set(y)
+	for i := int64(2); term.Sign() != 0 && term.MantExp(nil) > sum.MantExp(nil)-int(prec); i++ {
+		sum.Add(sum, term)
+		term.Mul(term, y).Quo(term, big.NewFloat(float64(i)))
+	}
+	var t big.Float
+	exp = new(big.Float).SetPrec(prec).Add(sum, big.NewFloat(1))
+	for range s {
+		t.SetPrec(prec).Add(sum, big.NewFloat(2))
+		sum.Mul(sum, &t)
+		exp.Mul(exp, exp)
+	}
+	return exp, sum
+}
+
+// bigLog returns log(x), to prec bits, as e⋅log(2) + 2⋅atanh((m-1)/(m+1)),
+// with x = m⋅2ᵉ, and m in [¾, 1½).
+func bigLog(x *big.Float, prec uint) *big.Float {
+	// atanh returns atanh(x), for |x| ≤ ⅓, by its Taylor series.
+	atanh := func(x *big.Float) *big.Float {
+		var z, p, t big.Float
+		z.SetPrec(prec).Mul(x, x)
+		p.SetPrec(prec).Set(x)
+		sum := new(big.Float).SetPrec(prec)
+		for i := int64(1); p.Sign() != 0 && p.MantExp(nil) > x.MantExp(nil)-int(prec); i += 2 {
+			sum.Add(sum, t.SetPrec(prec).Quo(&p, big.NewFloat(float64(i))))
+			p.Mul(&p, &z)
+		}
+		return sum
+	}
+
+	var m, p, q big.Float
+	e := x.MantExp(&m)
+	m.SetPrec(prec)
+	if m.Cmp(big.NewFloat(0.75)) < 0 {
+		m.SetMantExp(&m, 1)
+		e--
+	}
+	p.SetPrec(prec).Sub(&m, big.NewFloat(1))
+	q.SetPrec(prec).Add(&m, big.NewFloat(1))
+	l := atanh(p.Quo(&p, &q))
+	l.SetMantExp(l, 1)
+	if e != 0 {
+		ln2 := atanh(q.SetPrec(prec).Quo(big.NewFloat(1), big.NewFloat(3)))
+		ln2.SetMantExp(ln2, 1)
+		l.Add(l, ln2.Mul(ln2, big.NewFloat(float64(e))))
+	}
+	return l
+}
+
+func TestLog2(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+	}{
+		{Float(3), "1.584962500721156181453738943947816508759814407692481060455"},  // https://oeis.org/A020857
+		{Float(10), "3.321928094887362347870319429489390175864831393024580612054"}, // https://oeis.org/A020862
+		{Float(0.75), "-0.4150374992788438185462610560521834912402"},
+		{AddFloats(1, 0x1p-60), "1.251338478052702220545563465185380711036e-18"},
+		{Float(3 * 0x1p-1074), "-1072.415037499278843818546261056052183491"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := Log2(tt.arg); !near(got, tt.want) {
+				t.Errorf("Log2() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLog10(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+	}{
+		{Float(2), "0.3010299956639811952137388947244930267681898814621085413104"}, // https://oeis.org/A007524
+		{Float(3), "0.4771212547196624372950279032551153092001288641906958648298"}, // https://oeis.org/A114490
+		{Float(1e-5), "-4.99999999999999996447338508230199730083"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := Log10(tt.arg); !near(got, tt.want) {
+				t.Errorf("Log10() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLog2_specials(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Number
+		want Number
+	}{
+		{"Log2(1)", Log2(Float(1)), Number{}},
+		{"Log2(2⁻¹⁰⁷⁴)", Log2(Float(0x1p-1074)), Float(-1074)},
+		{"Log2(2¹⁰⁰⁰)", Log2(Float(0x1p1000)), Float(1000)},
+		{"Log2(-1)", Log2(Float(-1)), NaN()},
+		{"Log2(0)", Log2(Number{}), Inf(-1)},
+		{"Log2(Inf)", Log2(Inf(+1)), Inf(+1)},
+		{"Log2(NaN)", Log2(NaN()), NaN()},
+		{"Log10(1)", Log10(Float(1)), Number{}},
+		{"Log10(1000)", Log10(Float(1000)), Float(3)},
+		{"Log10(10⁴⁰)", Log10(Number{1e40, -0x1.0151182a7cp+78}), Float(40)},
+		{"Log10(-1)", Log10(Float(-1)), NaN()},
+		{"Log10(0)", Log10(Number{}), Inf(-1)},
+		{"Log10(Inf)", Log10(Inf(+1)), Inf(+1)},
+		{"Log10(NaN)", Log10(NaN()), NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !same(tt.got, tt.want) {
+				t.Errorf("got %#v, want %#v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExp2(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+	}{
+		{Float(0.5), "1.414213562373095048801688724209698078569671875376948073176"}, // https://oeis.org/A002193
+		{Float(-0.25), "0.8408964152537145430311254762332148950400342623567845108"},
+		{Float(100.75), "2131925691052275356552421013298.022094474"},
+		{Float(-900.5), "8.365442233360962515945664331321296920142e-272"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := Exp2(tt.arg); !near(got, tt.want) {
+				t.Errorf("Exp2() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExp10(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+	}{
+		{Float(0.5), "3.162277660168379331998893544432718533719555139325216826857"}, // https://oeis.org/A010467
+		{Float(-2.25), "0.005623413251903490803949510397764812314683"},
+		{Float(300.5), "3.16227766016837933199889354443271853372e+300"},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := Exp10(tt.arg); !near(got, tt.want) {
+				t.Errorf("Exp10() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExp2_specials(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Number
+		want Number
+	}{
+		{"Exp2(0)", Exp2(Number{}), Float(1)},
+		{"Exp2(10)", Exp2(Float(10)), Float(1024)},
+		{"Exp2(-1074)", Exp2(Float(-1074)), Float(0x1p-1074)},
+		{"Exp2(-1075)", Exp2(Float(-1075)), Number{}},
+		{"Exp2(1023)", Exp2(Float(1023)), Float(0x1p1023)},
+		{"Exp2(1024)", Exp2(Float(1024)), Inf(+1)},
+		{"Exp2(-Inf)", Exp2(Inf(-1)), Number{}},
+		{"Exp2(Inf)", Exp2(Inf(+1)), Inf(+1)},
+		{"Exp2(NaN)", Exp2(NaN()), NaN()},
+		{"Exp10(0)", Exp10(Number{}), Float(1)},
+		{"Exp10(3)", Exp10(Float(3)), Float(1000)},
+		{"Exp10(40)", Exp10(Float(40)), Number{1e40, -0x1.0151182a7cp+78}},
+		{"Exp10(309)", Exp10(Float(309)), Inf(+1)},
+		{"Exp10(-324)", Exp10(Float(-324)), Number{}},
+		{"Exp10(-Inf)", Exp10(Inf(-1)), Number{}},
+		{"Exp10(Inf)", Exp10(Inf(+1)), Inf(+1)},
+		{"Exp10(NaN)", Exp10(NaN()), NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !same(tt.got, tt.want) {
+				t.Errorf("got %#v, want %#v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExp2_big(t *testing.T) {
+	const prec = 3000
+	r := rand.New(rand.NewSource(1))
+
+	// check that |got - want| ≤ 2⁻¹⁰⁴⋅|want| + 2⁻¹⁰⁷³.
+	check := func(name string, got Number, want *big.Float, arg Number) {
+		t.Helper()
+		var d, e big.Float
+		d.SetPrec(prec).Sub(got.toBig(), want).Abs(&d)
+		e.SetPrec(prec).Abs(want).SetMantExp(&e, -104)
+		e.Add(&e, big.NewFloat(0x1p-1073))
+		if d.Cmp(&e) > 0 {
+			t.Fatalf("%s(%#v) = %#v, want %v", name, arg, got, want.Text('g', 40))
+		}
+	}
+
+	// root returns the k-th root of x, to prec bits, by Newton's method.
+	root := func(x *big.Float, k int) *big.Float {
+		// x = m⋅2ᵉ, with e a multiple of k.
+		e := x.MantExp(nil)
+		e -= (e%k + k) % k
+		f, _ := new(big.Float).SetMantExp(x, -e).Float64()
+		z := new(big.Float).SetPrec(prec).SetFloat64(math.Pow(f, 1/float64(k)))
+		z.SetMantExp(z, e/k)
+		var p, q big.Float
+		for range 12 {
+			// z += (x/zᵏ⁻¹ - z)/k
+			p.SetPrec(prec).SetInt64(1)
+			for range k - 1 {
+				p.Mul(&p, z)
+			}
+			q.SetPrec(prec).Quo(x, &p).Sub(&q, z).Quo(&q, big.NewFloat(float64(k)))
+			z.Add(z, &q)
+		}
+		return z
+	}
+
+	for range 1000 {
+		// n = i/8, and 2ⁿ = ⁸√(2ⁱ), 10ⁿ = ⁸√(10ⁱ).
+		i := r.Intn(16000) - 8000
+		n := Float(float64(i) / 8)
+
+		want := new(big.Float).SetPrec(prec).SetInt64(1)
+		want.SetMantExp(want, i)
+		check("Exp2", Exp2(n), root(want, 8), n)
+
+		// Log2 is the inverse of Exp2, unless it underflows.
+		if got, want := Log2(Exp2(n)), n; n.y > -968 && Cmp(Abs(Sub(got, want)), Float(0x1p-104*max(1, math.Abs(n.y)))) > 0 {
+			t.Fatalf("Log2(Exp2(%v)) = %#v", n, got)
+		}
+
+		i /= 25
+		n = Float(float64(i) / 8)
+		want.SetInt64(1)
+		for range max(i, -i) {
+			want.Mul(want, big.NewFloat(10))
+		}
+		if i < 0 {
+			want.Quo(big.NewFloat(1).SetPrec(prec), want)
+		}
+		check("Exp10", Exp10(n), root(want, 8), n)
+
+		// Log10 is the inverse of Exp10.
+		if got, want := Log10(Exp10(n)), n; Cmp(Abs(Sub(got, want)), Float(0x1p-104*max(1, math.Abs(n.y)))) > 0 {
+			t.Fatalf("Log10(Exp10(%v)) = %#v", n, got)
+		}
+	}
+}
+
+func BenchmarkLog(b *testing.B) {
+	n := Make(math.Pi, 0x1p-60)
+	for range b.N {
+		Log(n)
+	}
+}
+
+func BenchmarkLog1p(b *testing.B) {
+	n := Make(0x1p-10, 0x1p-70)
+	for range b.N {
+		Log1p(n)
+	}
+}
+
+func BenchmarkExp(b *testing.B) {
+	n := Make(math.Pi, 0x1p-60)
+	for range b.N {
+		Exp(n)
+	}
+}
+
+func BenchmarkExpm1(b *testing.B) {
+	n := Make(0x1p-10, 0x1p-70)
+	for range b.N {
+		Expm1(n)
 	}
 }
@@ -0,0 +1,113 @@
+package dbldbl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"math"
+)
+
+// MarshalText implements [encoding.TextMarshaler].
+// It uses the shortest representation that [Parse] will return exactly,
+// as [FormatNumber] with precision -1 does, which for a Number with a zero
+// low part, like Float(0.1), is the exact decimal value of its high part.
+func (n Number) MarshalText() ([]byte, error) {
+	return n.AppendText(nil)
+}
+
+// AppendText implements [encoding.TextAppender].
+func (n Number) AppendText(b []byte) ([]byte, error) {
+	return AppendNumber(b, n, 'g', -1), nil
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+// It accepts any string accepted by [Parse].
+func (n *Number) UnmarshalText(text []byte) error {
+	v, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*n = v
+	return nil
+}
+
+// MarshalJSON implements [json.Marshaler].
+//
+// Finite numbers are encoded as JSON numbers,
+// with the shortest representation that [Parse] will return exactly,
+// as for [Number.MarshalText].
+// NaN and infinities, which JSON numbers can't represent,
+// are encoded as the strings "NaN", "+Inf" and "-Inf".
+func (n Number) MarshalJSON() ([]byte, error) {
+	if !isFinite(n.y) {
+		b, _ := n.AppendText([]byte{'"'})
+		return append(b, '"'), nil
+	}
+	return n.AppendText(nil)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+//
+// It accepts JSON numbers, and JSON strings containing
+// any string accepted by [Parse]. The JSON null value is a no-op.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case string(data) == "null":
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return n.UnmarshalText([]byte(s))
+	case len(data) > 0 && (data[0] == '-' || '0' <= data[0] && data[0] <= '9') && json.Valid(data):
+		return n.UnmarshalText(data)
+	}
+	return errors.New("dbldbl: cannot unmarshal " + string(data) + " into Number")
+}
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// The encoding is 16 bytes long: the IEEE 754 binary64
+// high part followed by the low part, both big-endian.
+func (n Number) MarshalBinary() ([]byte, error) {
+	return n.AppendBinary(make([]byte, 0, 16))
+}
+
+// AppendBinary implements [encoding.BinaryAppender].
+func (n Number) AppendBinary(b []byte) ([]byte, error) {
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(n.y))
+	b = binary.BigEndian.AppendUint64(b, math.Float64bits(n.x))
+	return b, nil
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+//
+// It rejects encodings of the wrong length,
+// and of high and low parts that don't form a valid double-double.
+func (n *Number) UnmarshalBinary(data []byte) error {
+	if len(data) != 16 {
+		return errors.New("dbldbl: invalid binary encoding length")
+	}
+	v := Number{
+		math.Float64frombits(binary.BigEndian.Uint64(data[0:])),
+		math.Float64frombits(binary.BigEndian.Uint64(data[8:])),
+	}
+	if !IsCanonical(v) {
+		return errors.New("dbldbl: invalid binary encoding")
+	}
+	*n = v
+	return nil
+}
+
+// GobEncode implements [encoding/gob.GobEncoder].
+func (n Number) GobEncode() ([]byte, error) {
+	return n.MarshalBinary()
+}
+
+// GobDecode implements [encoding/gob.GobDecoder].
+func (n *Number) GobDecode(data []byte) error {
+	return n.UnmarshalBinary(data)
+}
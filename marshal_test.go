@@ -0,0 +1,216 @@
+package dbldbl
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+)
+
+func TestNumber_MarshalText(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+	}{
+		{Number{}, "0"},
+		{Number{-zero, 0}, "-0"},
+		{Number{1, 0x1p-60}, "1.0000000000000000008673617379884035"},
+		{Pi, "3.1415926535897932384626433832795"},
+		{Number{0x1p-1074, 0}, "5e-324"},
+		{Number{1e22, 0}, "1e+22"},
+		{NaN(), "NaN"},
+		{Inf(+1), "+Inf"},
+		{Inf(-1), "-Inf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			b, err := tt.arg.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(b); got != tt.want {
+				t.Errorf("MarshalText() = %q, want %q", got, tt.want)
+			}
+			var n Number
+			if err := n.UnmarshalText(b); err != nil {
+				t.Fatal(err)
+			}
+			if !same(n, tt.arg) {
+				t.Errorf("UnmarshalText() = %#v, want %#v", n, tt.arg)
+			}
+		})
+	}
+}
+
+func TestNumber_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+	}{
+		{Number{}, `0`},
+		{Number{-zero, 0}, `-0`},
+		{Number{1e-7, 0}, `9.99999999999999954748111825886258685613938723690807819366455078125e-08`},
+		{Number{1, -0x1p-60}, `0.9999999999999999991326382620115965`},
+		{Sqrt2, `1.4142135623730950488016887242097`},
+		{NaN(), `"NaN"`},
+		{Inf(+1), `"+Inf"`},
+		{Inf(-1), `"-Inf"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			b, err := json.Marshal(tt.arg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := string(b); got != tt.want {
+				t.Errorf("MarshalJSON() = %s, want %s", got, tt.want)
+			}
+			var n Number
+			if err := json.Unmarshal(b, &n); err != nil {
+				t.Fatal(err)
+			}
+			if !same(n, tt.arg) {
+				t.Errorf("UnmarshalJSON() = %#v, want %#v", n, tt.arg)
+			}
+		})
+	}
+}
+
+func TestNumber_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want Number
+		err  bool
+	}{
+		{`{"N": 1.5}`, Number{1.5, 0}, false},
+		{`{"N": -15e-1}`, Number{-1.5, 0}, false},
+		{`{"N": "0x1p-1"}`, Number{0.5, 0}, false},
+		{`{"N": "Number{1, -0x1p-60}"}`, Number{1, -0x1p-60}, false},
+		{`{"N": "inf"}`, Inf(+1), false},
+		{`{"N": "NaN"}`, NaN(), false},
+		{`{"N": null}`, Pi, false},
+		{`{}`, Pi, false},
+		{`{"N": true}`, Pi, true},
+		{`{"N": "x"}`, Pi, true},
+		{`{"N": 1e400}`, Pi, true},
+		{`{"N": [1]}`, Pi, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			v := struct{ N Number }{Pi}
+			err := json.Unmarshal([]byte(tt.arg), &v)
+			if (err != nil) != tt.err {
+				t.Errorf("Unmarshal() error = %v", err)
+			}
+			if !same(v.N, tt.want) {
+				t.Errorf("Unmarshal() = %#v, want %#v", v.N, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumber_MarshalXML(t *testing.T) {
+	type T struct {
+		A Number `xml:"a,attr"`
+		B Number `xml:"b"`
+	}
+	want := T{Pi, Neg(E)}
+	b, err := xml.Marshal(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got T
+	if err := xml.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !same(got.A, want.A) || !same(got.B, want.B) {
+		t.Errorf("Unmarshal(%s) = %#v, want %#v", b, got, want)
+	}
+}
+
+func TestNumber_MarshalBinary(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+	}{
+		{Number{}, "00000000000000000000000000000000"},
+		{Number{-zero, 0}, "80000000000000000000000000000000"},
+		{Number{1, 0x1p-60}, "3ff00000000000003c30000000000000"},
+		{Number{1, 0x1p-53}, "3ff00000000000003ca0000000000000"},
+		{Number{1, -0x1p-54}, "3ff0000000000000bc90000000000000"},
+		{Inf(-1), "fff00000000000000000000000000000"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			b, err := tt.arg.MarshalBinary()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := hex.EncodeToString(b); got != tt.want {
+				t.Errorf("MarshalBinary() = %s, want %s", got, tt.want)
+			}
+			var n Number
+			if err := n.UnmarshalBinary(b); err != nil {
+				t.Fatal(err)
+			}
+			if !same(n, tt.arg) {
+				t.Errorf("UnmarshalBinary() = %#v, want %#v", n, tt.arg)
+			}
+		})
+	}
+}
+
+func TestNumber_UnmarshalBinary_errors(t *testing.T) {
+	tests := []string{
+		"",
+		"3ff0000000000000",
+		"3ff00000000000000000000000000000ff",
+		"3ff00000000000003ff0000000000000", // lo too large
+		"3ff0000000000001bca0000000000000", // lo a tie, hi odd
+		"3ff0000000000000bca0000000000000", // hi-lo is a float64
+		"3ff00000000000008000000000000000", // negative zero lo
+		"00000000000000000000000000000001", // zero hi
+		"7ff00000000000003ff0000000000000", // infinite hi
+		"3ff00000000000007ff8000000000000", // NaN lo
+		"7ff80000000000008000000000000000", // NaN hi
+	}
+	for _, tt := range tests {
+		t.Run(tt, func(t *testing.T) {
+			b, _ := hex.DecodeString(tt)
+			n := Pi
+			if err := n.UnmarshalBinary(b); err == nil {
+				t.Errorf("UnmarshalBinary() = %#v, want error", n)
+			}
+			if !same(n, Pi) {
+				t.Errorf("UnmarshalBinary() = %#v, want unchanged", n)
+			}
+		})
+	}
+}
+
+func TestNumber_GobEncode(t *testing.T) {
+	type T struct {
+		A, B Number
+		C    []Number
+	}
+	want := T{Pi, Neg(E), []Number{Sqrt2, NaN(), Inf(+1), {}}}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(want); err != nil {
+		t.Fatal(err)
+	}
+	var got T
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if !same(got.A, want.A) || !same(got.B, want.B) || len(got.C) != len(want.C) {
+		t.Fatalf("Decode() = %#v, want %#v", got, want)
+	}
+	for i := range got.C {
+		if !same(got.C[i], want.C[i]) {
+			t.Errorf("Decode() = %#v, want %#v", got.C[i], want.C[i])
+		}
+	}
+}
@@ -1,11 +1,24 @@
 // Package dbldbl provides double-double precision arithmetic.
 package dbldbl
 
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
 // Number is a double-double precision number.
 type Number struct {
 	y, x float64
 }
 
+// Make creates a Number from the sum of hi and lo (exact).
+// The result is renormalized, so hi and lo need not
+// be the high and low parts of a canonical Number.
+func Make(hi, lo float64) Number {
+	return AddFloats(hi, lo)
+}
+
 // Float creates a Number from a float64.
 func Float(a float64) Number {
 	return Number{y: a}
@@ -34,6 +47,136 @@ func (n Number) Float() (_ float64, exact bool) {
 	return n.y, n.x == 0
 }
 
+// Float64 returns the float64 value of this Number, rounded according to mode,
+// and the accuracy of the result.
+// Overflow and underflow round like any other value:
+// the result is ±Inf or the largest finite float64 for the former,
+// and ±0 or the smallest denormal for the latter, depending on mode.
+func (n Number) Float64(mode big.RoundingMode) (float64, big.Accuracy) {
+	if n.x == 0 {
+		return n.y, big.Exact
+	}
+
+	// The high part is the nearest float64,
+	// and n is between it and one of its neighbors.
+	lo, hi := n.y, n.y
+	if n.x > 0 {
+		hi = math.Nextafter(n.y, math.Inf(+1))
+	} else {
+		lo = math.Nextafter(n.y, math.Inf(-1))
+	}
+	tie := math.Abs(n.x) == (hi-lo)/2
+	return round(lo, hi, n.y, tie, n.y < 0, mode)
+}
+
+// Float32 returns the float32 value of this Number, rounded according to mode,
+// and the accuracy of the result.
+// Overflow and underflow round like any other value:
+// the result is ±Inf or the largest finite float32 for the former,
+// and ±0 or the smallest denormal for the latter, depending on mode.
+func (n Number) Float32(mode big.RoundingMode) (float32, big.Accuracy) {
+	// Overflow is 2¹²⁸, which float64 can represent.
+	const ovf = 0x1p128
+
+	if !isFinite(n.y) {
+		return float32(n.y), big.Exact
+	}
+
+	// The nearest float32 to the high part.
+	a := ovf
+	if math.Abs(n.y) < ovf {
+		a = float64(float32(n.y))
+		if math.IsInf(a, 0) {
+			a = ovf
+		}
+	}
+	a = math.Copysign(a, n.y)
+
+	// The sign of the remainder.
+	d := AddFloat(Float(n.y-a), n.x)
+	if d.y == 0 && math.Abs(a) != ovf {
+		return float32(a), big.Exact
+	}
+
+	// n is between a and one of its neighbors.
+	next := func(f float64, dir float32) float64 {
+		r := float64(math.Nextafter32(float32(f), dir))
+		if math.IsInf(r, 0) {
+			return math.Copysign(ovf, r)
+		}
+		return r
+	}
+	var lo, hi float64
+	switch {
+	case a == +ovf:
+		lo, hi = math.MaxFloat32, a
+	case a == -ovf:
+		lo, hi = a, -math.MaxFloat32
+	case d.y > 0:
+		lo, hi = a, next(a, float32(math.Inf(+1)))
+	default:
+		lo, hi = next(a, float32(math.Inf(-1))), a
+	}
+
+	// If the high part is halfway between lo and hi,
+	// the low part breaks the tie.
+	near := a
+	tie := math.Abs(n.y-a) == (hi-lo)/2
+	if tie && n.x != 0 {
+		tie = false
+		if n.x > 0 {
+			near = hi
+		} else {
+			near = lo
+		}
+	}
+
+	r, acc := round(lo, hi, near, tie, n.y < 0, mode)
+	if math.Abs(r) == ovf {
+		return float32(math.Copysign(math.Inf(+1), r)), acc
+	}
+	return float32(r), acc
+}
+
+// round picks lo or hi, which bracket some value strictly,
+// according to mode, and returns the accuracy of the result.
+// The nearest of the two is near, tie reports if the value is halfway,
+// and neg if it's negative.
+func round(lo, hi, near float64, tie, neg bool, mode big.RoundingMode) (float64, big.Accuracy) {
+	var r float64
+	switch mode {
+	case big.ToNearestEven:
+		r = near
+	case big.ToNearestAway:
+		r = near
+		if tie && neg {
+			r = lo
+		} else if tie {
+			r = hi
+		}
+	case big.ToZero:
+		r = hi
+		if !neg {
+			r = lo
+		}
+	case big.AwayFromZero:
+		r = lo
+		if !neg {
+			r = hi
+		}
+	case big.ToNegativeInf:
+		r = lo
+	case big.ToPositiveInf:
+		r = hi
+	default:
+		panic("dbldbl: invalid rounding mode")
+	}
+	if r == hi {
+		return r, big.Above
+	}
+	return r, big.Below
+}
+
 // Int converts this Number to an int64.
 func (n Number) Int() int64 {
 	return int64(n.y) + int64(n.x)
@@ -43,3 +186,197 @@ func (n Number) Int() int64 {
 func (n Number) Uint() uint64 {
 	return uint64(n.y) + uint64(n.x)
 }
+
+// Int64 returns the int64 value of this Number, rounded to an integer
+// according to mode, and the accuracy of the result.
+// If the rounded value doesn't fit an int64, the result saturates:
+// it's math.MinInt64 (and [big.Above]) or math.MaxInt64 (and [big.Below]).
+// A NaN converts to 0, with accuracy [big.Exact].
+func (n Number) Int64(mode big.RoundingMode) (int64, big.Accuracy) {
+	if IsNaN(n) {
+		return 0, big.Exact
+	}
+
+	r := roundInt(n, mode)
+	switch {
+	case Cmp(r, Float(-0x1p63)) < 0:
+		return math.MinInt64, big.Above
+	case Cmp(r, Float(0x1p63)) >= 0:
+		return math.MaxInt64, big.Below
+	case r.y == 0x1p63:
+		// The high part rounded up, the low part is negative.
+		return math.MaxInt64 + (int64(r.x) + 1), accuracy(r, n)
+	}
+	return int64(r.y) + int64(r.x), accuracy(r, n)
+}
+
+// Uint64 returns the uint64 value of this Number, rounded to an integer
+// according to mode, and the accuracy of the result.
+// If the rounded value doesn't fit a uint64, the result saturates:
+// it's 0 (and [big.Above]) or math.MaxUint64 (and [big.Below]).
+// A NaN converts to 0, with accuracy [big.Exact].
+func (n Number) Uint64(mode big.RoundingMode) (uint64, big.Accuracy) {
+	if IsNaN(n) {
+		return 0, big.Exact
+	}
+
+	r := roundInt(n, mode)
+	switch {
+	case r.y < 0:
+		return 0, big.Above
+	case Cmp(r, Float(0x1p64)) >= 0:
+		return math.MaxUint64, big.Below
+	case r.y == 0x1p64:
+		// The high part rounded up, the low part is negative.
+		return math.MaxUint64 - (uint64(-r.x) - 1), accuracy(r, n)
+	case r.x < 0:
+		return uint64(r.y) - uint64(-r.x), accuracy(r, n)
+	}
+	return uint64(r.y) + uint64(r.x), accuracy(r, n)
+}
+
+// roundInt rounds n to an integer according to mode.
+func roundInt(n Number, mode big.RoundingMode) Number {
+	switch mode {
+	case big.ToNearestEven:
+		// Round breaks ties away from zero, so
+		// if that's odd, the even neighbor is towards zero.
+		r := Round(n)
+		if isOdd(r) && Cmp(Abs(Sub(n, Trunc(n))), Float(0.5)) == 0 {
+			return Trunc(n)
+		}
+		return r
+	case big.ToNearestAway:
+		return Round(n)
+	case big.ToZero:
+		return Trunc(n)
+	case big.AwayFromZero:
+		if Signbit(n) {
+			return Floor(n)
+		}
+		return Ceil(n)
+	case big.ToNegativeInf:
+		return Floor(n)
+	case big.ToPositiveInf:
+		return Ceil(n)
+	default:
+		panic("dbldbl: invalid rounding mode")
+	}
+}
+
+// accuracy returns the accuracy of r, as an approximation of n.
+func accuracy(r, n Number) big.Accuracy {
+	return big.Accuracy(Cmp(r, n))
+}
+
+// isOdd reports whether the integer n is odd.
+func isOdd(n Number) bool {
+	return (math.Mod(n.y, 2) != 0) != (math.Mod(n.x, 2) != 0)
+}
+
+// Int128 returns the value of this Number as a 128-bit two's complement integer,
+// split into its high and low 64-bit words.
+// If n is not an integer in the range of int128, Int128 returns 0, 0, false.
+func (n Number) Int128() (hi int64, lo uint64, ok bool) {
+	if !isFinite(n.y) || Cmp(Trunc(n), n) != 0 ||
+		Cmp(n, Float(-0x1p127)) < 0 || Cmp(n, Float(0x1p127)) >= 0 {
+		return 0, 0, false
+	}
+	yh, yl := toUint128(n.y)
+	xh, xl := toUint128(n.x)
+	l, c := bits.Add64(yl, xl, 0)
+	h, _ := bits.Add64(yh, xh, c)
+	return int64(h), l, true
+}
+
+// FromInt128 returns the Number nearest to the 128-bit two's complement
+// integer with high and low 64-bit words hi and lo:
+// its high part is the float64 nearest to the integer,
+// and its low part is the float64 nearest to the remainder.
+func FromInt128(hi int64, lo uint64) Number {
+	// The magnitude of the integer.
+	h, l := uint64(hi), lo
+	if hi < 0 {
+		h, l = neg128(h, l)
+	}
+
+	y := fromUint128(h, l)
+	// The remainder, and its magnitude.
+	yh, yl := toUint128(y)
+	l, b := bits.Sub64(l, yl, 0)
+	h, b = bits.Sub64(h, yh, b)
+	if b != 0 {
+		h, l = neg128(h, l)
+	}
+	x := fromUint128(h, l)
+	if b != 0 {
+		x = -x
+	}
+
+	r := renormalize(y, x)
+	if hi < 0 {
+		return Neg(r)
+	}
+	return r
+}
+
+// toUint128 returns the integer f, with |f| < 2¹²⁸,
+// as a 128-bit two's complement integer.
+func toUint128(f float64) (hi, lo uint64) {
+	m, e := split(f)
+	switch {
+	case e <= -64:
+		// Only zero.
+	case e < 0:
+		lo = m >> -e
+	case e < 64:
+		hi, lo = m>>(64-e), m<<e
+	default:
+		hi = m << (e - 64)
+	}
+	if f < 0 {
+		return neg128(hi, lo)
+	}
+	return hi, lo
+}
+
+// fromUint128 returns the float64 nearest to the unsigned 128-bit integer hi⋅2⁶⁴ + lo.
+func fromUint128(hi, lo uint64) float64 {
+	if hi == 0 {
+		return float64(lo)
+	}
+	// The top 64 bits, with a sticky bit for the others,
+	// round the same as the full 128 bits.
+	n := bits.Len64(hi)
+	t := hi<<(64-n) | lo>>n
+	if lo<<(64-n) != 0 {
+		t |= 1
+	}
+	return math.Ldexp(float64(t), n)
+}
+
+// neg128 returns the two's complement negation of hi⋅2⁶⁴ + lo.
+func neg128(hi, lo uint64) (uint64, uint64) {
+	lo, b := bits.Sub64(0, lo, 0)
+	hi, _ = bits.Sub64(0, hi, b)
+	return hi, lo
+}
+
+// Parts returns the high and low parts of this Number.
+// For a canonical Number, hi is the float64 nearest to the value,
+// and lo is the float64 nearest to the remainder.
+func (n Number) Parts() (hi, lo float64) {
+	return n.y, n.x
+}
+
+// IsCanonical reports whether n is a canonical Number:
+// its high part is the float64 nearest to n,
+// and its low part is no larger than half an ulp of the high part;
+// if the high part is zero, an infinity or a NaN, the low part is +0.
+// All functions in this package return canonical Numbers.
+func IsCanonical(n Number) bool {
+	if n.y == 0 || !isFinite(n.y) || n.x == 0 {
+		return math.Float64bits(n.x) == 0
+	}
+	return isFinite(n.x) && n.y+n.x == n.y
+}
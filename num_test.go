@@ -3,6 +3,7 @@ package dbldbl
 import (
 	"math"
 	"math/big"
+	"math/rand"
 	"testing"
 )
 
@@ -103,3 +104,373 @@ func fromBig(b *big.Float) (n Number) {
 	}
 	return n
 }
+
+func TestMake(t *testing.T) {
+	tests := []struct {
+		hi, lo float64
+		want   Number
+	}{
+		{0, 0, Number{}},
+		{-zero, 0, Number{}},
+		{-zero, -zero, Number{-zero, 0}},
+		{1, 0x1p-60, Number{1, 0x1p-60}},
+		{0x1p-60, 1, Number{1, 0x1p-60}},
+		{1, -zero, Number{1, 0}},
+		{1, 1, Number{2, 0}},
+		{1, 0x1p-53, Number{1, 0x1p-53}},
+		{1 + 0x1p-52, 0x1p-53, Number{1 + 0x1p-51, -0x1p-53}},
+		{math.MaxFloat64, math.MaxFloat64, Inf(+1)},
+		{math.Inf(-1), 1, Inf(-1)},
+		{math.Inf(+1), math.Inf(-1), NaN()},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got := Make(tt.hi, tt.lo)
+			if !same(got, tt.want) {
+				t.Errorf("Make() = %#v, want %#v", got, tt.want)
+			}
+			if !IsCanonical(got) {
+				t.Errorf("IsCanonical(%#v) = false", got)
+			}
+			if hi, lo := got.Parts(); !same(Number{hi, lo}, got) {
+				t.Errorf("Parts() = %v, %v", hi, lo)
+			}
+		})
+	}
+}
+
+func TestIsCanonical(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want bool
+	}{
+		{Number{}, true},
+		{Number{-zero, 0}, true},
+		{Number{1, 0x1p-60}, true},
+		{Number{1, 0x1p-53}, true},
+		{Number{1, -0x1p-54}, true},
+		{Number{1, -0x1p-53}, false},
+		{Number{1 + 0x1p-52, 0x1p-53}, false},
+		{Number{1, 1}, false},
+		{Number{1, -zero}, false},
+		{Number{0, 0x1p-1074}, false},
+		{Number{0, -zero}, false},
+		{Number{1, math.NaN()}, false},
+		{Inf(+1), true},
+		{Number{math.Inf(+1), 1}, false},
+		{NaN(), true},
+		{Number{math.NaN(), 1}, false},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := IsCanonical(tt.arg); got != tt.want {
+				t.Errorf("IsCanonical(%#v) = %v, want %v", tt.arg, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsCanonical_results(t *testing.T) {
+	unary := map[string]func(Number) Number{
+		"Neg": Neg, "Abs": Abs, "Trunc": Trunc, "Floor": Floor, "Ceil": Ceil, "Round": Round,
+		"Sqr": Sqr, "Sqrt": Sqrt, "Cbrt": Cbrt, "Inv": Inv, "InvSqrt": InvSqrt,
+		"Log": Log, "Exp": Exp, "Log1p": Log1p, "Expm1": Expm1,
+		"Sin": Sin, "Cos": Cos, "Tan": Tan, "Asin": Asin, "Acos": Acos, "Atan": Atan,
+		"Sinh": Sinh, "Cosh": Cosh, "Tanh": Tanh, "Asinh": Asinh, "Acosh": Acosh, "Atanh": Atanh,
+		"Ldexp":  func(n Number) Number { return Ldexp(n, -1040) },
+		"Sincos": func(n Number) Number { _, c := Sincos(n); return c },
+	}
+	binary := map[string]func(Number, Number) Number{
+		"Add": Add, "Sub": Sub, "Mul": Mul, "Div": Div, "Pow": Pow, "Atan2": Atan2,
+		"AddFloat": func(a, b Number) Number { return AddFloat(a, b.y) },
+		"SubFloat": func(a, b Number) Number { return SubFloat(a.y, b) },
+		"MulFloat": func(a, b Number) Number { return MulFloat(a, b.y) },
+		"FMA":      func(a, b Number) Number { return FMA(a, b, a) },
+		"FMAFloat": func(a, b Number) Number { return FMAFloat(a.y, b.y, b) },
+	}
+
+	r := rand.New(rand.NewSource(1))
+	random := func() Number {
+		switch r.Intn(6) {
+		case 0:
+			return Float(float64(r.Intn(21)-10) / 4)
+		case 1:
+			// Integers with a small fractional part.
+			k := math.Ldexp(float64(r.Int63n(1<<53)), r.Intn(60))
+			return Make(k, float64(r.Intn(9)-4)/4*math.Ldexp(1, -r.Intn(80)))
+		case 2:
+			return Neg(Make(math.Ldexp(r.Float64(), r.Intn(20)-10), math.Ldexp(r.Float64(), -r.Intn(100)-60)))
+		}
+		return Make(math.Ldexp(r.Float64()-0.5, r.Intn(200)-100), math.Ldexp(r.Float64(), r.Intn(200)-200))
+	}
+
+	for range 1000 {
+		a, b := random(), random()
+		for name, f := range unary {
+			if got := f(a); !IsCanonical(got) {
+				t.Fatalf("%s(%#v) = %#v", name, a, got)
+			}
+		}
+		for name, f := range binary {
+			if got := f(a, b); !IsCanonical(got) {
+				t.Fatalf("%s(%#v, %#v) = %#v", name, a, b, got)
+			}
+		}
+	}
+}
+
+var roundingModes = [...]big.RoundingMode{
+	big.ToNearestEven, big.ToNearestAway, big.ToZero,
+	big.AwayFromZero, big.ToNegativeInf, big.ToPositiveInf,
+}
+
+func TestNumber_Float64(t *testing.T) {
+	const next = 1 + 0x1p-52
+	tests := []struct {
+		arg  Number
+		want [len(roundingModes)]float64
+	}{
+		{Number{}, [...]float64{0, 0, 0, 0, 0, 0}},
+		{Number{1, 0x1p-60}, [...]float64{1, 1, 1, next, 1, next}},
+		{Number{1, -0x1p-60}, [...]float64{1, 1, 1 - 0x1p-53, 1, 1 - 0x1p-53, 1}},
+		{Number{-1, 0x1p-60}, [...]float64{-1, -1, -1 + 0x1p-53, -1, -1, -1 + 0x1p-53}},
+		{Number{1, 0x1p-53}, [...]float64{1, next, 1, next, 1, next}},
+		{Number{-1, -0x1p-53}, [...]float64{-1, -next, -1, -next, -next, -1}},
+		{Number{2, -0x1p-53}, [...]float64{2, 2, 2 - 0x1p-52, 2, 2 - 0x1p-52, 2}},
+		{Number{math.MaxFloat64, 0x1p960}, [...]float64{math.MaxFloat64, math.MaxFloat64, math.MaxFloat64, math.Inf(+1), math.MaxFloat64, math.Inf(+1)}},
+		{Inf(-1), [...]float64{math.Inf(-1), math.Inf(-1), math.Inf(-1), math.Inf(-1), math.Inf(-1), math.Inf(-1)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg.GoString(), func(t *testing.T) {
+			for i, mode := range roundingModes {
+				got, acc := tt.arg.Float64(mode)
+				if got != tt.want[i] {
+					t.Errorf("Float64(%v) = %v, want %v", mode, got, tt.want[i])
+				}
+				if want := big.Accuracy(-Cmp(tt.arg, Float(got))); acc != want {
+					t.Errorf("Float64(%v) accuracy = %v, want %v", mode, acc, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNumber_Float32(t *testing.T) {
+	const (
+		max  = math.MaxFloat32
+		tiny = 0x1p-149
+	)
+	inf := float32(math.Inf(+1))
+	nz := float32(-zero)
+	tests := []struct {
+		arg  Number
+		want [len(roundingModes)]float32
+	}{
+		{Number{}, [...]float32{0, 0, 0, 0, 0, 0}},
+		{Number{1, 0}, [...]float32{1, 1, 1, 1, 1, 1}},
+		{Number{1, 0x1p-60}, [...]float32{1, 1, 1, 1 + 0x1p-23, 1, 1 + 0x1p-23}},
+		{Number{1, -0x1p-60}, [...]float32{1, 1, 1 - 0x1p-24, 1, 1 - 0x1p-24, 1}},
+		{Number{1 + 0x1p-24, 0}, [...]float32{1, 1 + 0x1p-23, 1, 1 + 0x1p-23, 1, 1 + 0x1p-23}},
+		{Number{1 + 0x1p-24, 0x1p-80}, [...]float32{1 + 0x1p-23, 1 + 0x1p-23, 1, 1 + 0x1p-23, 1, 1 + 0x1p-23}},
+		{Number{1 + 0x1p-24, -0x1p-80}, [...]float32{1, 1, 1, 1 + 0x1p-23, 1, 1 + 0x1p-23}},
+		{Number{-1 - 0x3p-24, 0}, [...]float32{-1 - 0x1p-22, -1 - 0x1p-22, -1 - 0x1p-23, -1 - 0x1p-22, -1 - 0x1p-22, -1 - 0x1p-23}},
+		{Number{-1 - 0x1p-30, 0x1p-90}, [...]float32{-1, -1, -1, -1 - 0x1p-23, -1 - 0x1p-23, -1}},
+		{Number{0x1p-150, 0}, [...]float32{0, tiny, 0, tiny, 0, tiny}},
+		{Number{0x1p-150, 0x1p-210}, [...]float32{tiny, tiny, 0, tiny, 0, tiny}},
+		{Number{-0x1p-150, 0x1p-210}, [...]float32{nz, nz, nz, -tiny, -tiny, nz}},
+		{Number{0x1p-1000, 0}, [...]float32{0, 0, 0, tiny, 0, tiny}},
+		{Number{max, 0x1p-60}, [...]float32{max, max, max, inf, max, inf}},
+		{Number{0x1p128 - 0x1p103, 0}, [...]float32{inf, inf, max, inf, max, inf}},
+		{Number{0x1p128 - 0x1p103, -0x1p-60}, [...]float32{max, max, max, inf, max, inf}},
+		{Number{-0x1p128, 0}, [...]float32{-inf, -inf, -max, -inf, -inf, -max}},
+		{Number{1e300, 0}, [...]float32{inf, inf, max, inf, max, inf}},
+		{Inf(-1), [...]float32{-inf, -inf, -inf, -inf, -inf, -inf}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg.GoString(), func(t *testing.T) {
+			for i, mode := range roundingModes {
+				got, acc := tt.arg.Float32(mode)
+				if math.Float32bits(got) != math.Float32bits(tt.want[i]) {
+					t.Errorf("Float32(%v) = %v, want %v", mode, got, tt.want[i])
+				}
+				if want := big.Accuracy(-Cmp(tt.arg, Float(float64(got)))); acc != want {
+					t.Errorf("Float32(%v) accuracy = %v, want %v", mode, acc, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNumber_Float_big(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 2000 {
+		n := Make(math.Ldexp(r.Float64()-0.5, r.Intn(200)-100), math.Ldexp(r.Float64()-0.5, r.Intn(100)-160))
+		if r.Intn(4) == 0 {
+			// Near a float32 tie.
+			f := math.Ldexp(float64(r.Int63n(1<<25)|1), r.Intn(60)-40)
+			n = Make(f, math.Ldexp(float64(r.Intn(3)-1), r.Intn(60)-100))
+		}
+		for _, mode := range roundingModes {
+			var b big.Float
+			b.SetPrec(53).SetMode(mode).Set(n.BigFloat(nil))
+			want64, _ := b.Float64()
+			got64, _ := n.Float64(mode)
+			if got64 != want64 {
+				t.Fatalf("%#v.Float64(%v) = %v, want %v", n, mode, got64, want64)
+			}
+			b.SetPrec(24).SetMode(mode).Set(n.BigFloat(nil))
+			want32, _ := b.Float32()
+			got32, _ := n.Float32(mode)
+			if got32 != want32 {
+				t.Fatalf("%#v.Float32(%v) = %v, want %v", n, mode, got32, want32)
+			}
+		}
+	}
+}
+
+func TestNumber_Int64(t *testing.T) {
+	const (
+		min = math.MinInt64
+		max = math.MaxInt64
+	)
+	tests := []struct {
+		arg  Number
+		want [len(roundingModes)]int64
+	}{
+		{Number{}, [...]int64{0, 0, 0, 0, 0, 0}},
+		{Float(2.5), [...]int64{2, 3, 2, 3, 2, 3}},
+		{Float(-2.5), [...]int64{-2, -3, -2, -3, -3, -2}},
+		{Float(3.5), [...]int64{4, 4, 3, 4, 3, 4}},
+		{Number{1, 0x1p-60}, [...]int64{1, 1, 1, 2, 1, 2}},
+		{Number{-1, 0x1p-60}, [...]int64{-1, -1, 0, -1, -1, 0}},
+		{Number{0x1p62, -0.5}, [...]int64{1 << 62, 1 << 62, 1<<62 - 1, 1 << 62, 1<<62 - 1, 1 << 62}},
+		{Number{0x1p63, -1}, [...]int64{max, max, max, max, max, max}},
+		{Number{0x1p63, -0.5}, [...]int64{max, max, max, max, max, max}},
+		{Number{-0x1p63, 0}, [...]int64{min, min, min, min, min, min}},
+		{Number{-0x1p63, -0.5}, [...]int64{min, min, min, min, min, min}},
+		{Float(1e300), [...]int64{max, max, max, max, max, max}},
+		{Inf(-1), [...]int64{min, min, min, min, min, min}},
+		{NaN(), [...]int64{0, 0, 0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg.GoString(), func(t *testing.T) {
+			for i, mode := range roundingModes {
+				got, acc := tt.arg.Int64(mode)
+				if got != tt.want[i] {
+					t.Errorf("Int64(%v) = %v, want %v", mode, got, tt.want[i])
+				}
+				want := big.Exact
+				if !IsNaN(tt.arg) {
+					want = big.Accuracy(Cmp(Int(got), tt.arg))
+				}
+				if acc != want {
+					t.Errorf("Int64(%v) accuracy = %v, want %v", mode, acc, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNumber_Uint64(t *testing.T) {
+	const max = math.MaxUint64
+	tests := []struct {
+		arg  Number
+		want [len(roundingModes)]uint64
+	}{
+		{Number{}, [...]uint64{0, 0, 0, 0, 0, 0}},
+		{Float(0.5), [...]uint64{0, 1, 0, 1, 0, 1}},
+		{Float(-0.5), [...]uint64{0, 0, 0, 0, 0, 0}},
+		{Float(-1), [...]uint64{0, 0, 0, 0, 0, 0}},
+		{Number{0x1p63, 1}, [...]uint64{1<<63 + 1, 1<<63 + 1, 1<<63 + 1, 1<<63 + 1, 1<<63 + 1, 1<<63 + 1}},
+		{Number{0x1p63, -0.5}, [...]uint64{1 << 63, 1 << 63, 1<<63 - 1, 1 << 63, 1<<63 - 1, 1 << 63}},
+		{Number{0x1p64, -1}, [...]uint64{max, max, max, max, max, max}},
+		{Number{0x1p64, -0.5}, [...]uint64{max, max, max, max, max, max}},
+		{Number{0x1p64, 0}, [...]uint64{max, max, max, max, max, max}},
+		{Inf(+1), [...]uint64{max, max, max, max, max, max}},
+		{NaN(), [...]uint64{0, 0, 0, 0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg.GoString(), func(t *testing.T) {
+			for i, mode := range roundingModes {
+				got, acc := tt.arg.Uint64(mode)
+				if got != tt.want[i] {
+					t.Errorf("Uint64(%v) = %v, want %v", mode, got, tt.want[i])
+				}
+				want := big.Exact
+				if !IsNaN(tt.arg) {
+					want = big.Accuracy(Cmp(Uint(got), tt.arg))
+				}
+				if acc != want {
+					t.Errorf("Uint64(%v) accuracy = %v, want %v", mode, acc, want)
+				}
+			}
+		})
+	}
+}
+
+func TestNumber_Int128(t *testing.T) {
+	tests := []struct {
+		arg Number
+		hi  int64
+		lo  uint64
+		ok  bool
+	}{
+		{Number{}, 0, 0, true},
+		{Float(1), 0, 1, true},
+		{Float(-1), -1, math.MaxUint64, true},
+		{Number{0x1p100, 1}, 1 << 36, 1, true},
+		{Number{0x1p100, -1}, 1<<36 - 1, math.MaxUint64, true},
+		{Number{-0x1p100, -1}, -1<<36 - 1, math.MaxUint64, true},
+		{Number{0x1p127, -1}, math.MaxInt64, math.MaxUint64, true},
+		{Float(-0x1p127), math.MinInt64, 0, true},
+		{Float(0x1p127), 0, 0, false},
+		{Number{-0x1p127, -1}, 0, 0, false},
+		{Float(0.5), 0, 0, false},
+		{Number{0x1p100, 0.5}, 0, 0, false},
+		{Inf(-1), 0, 0, false},
+		{NaN(), 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg.GoString(), func(t *testing.T) {
+			hi, lo, ok := tt.arg.Int128()
+			if hi != tt.hi || lo != tt.lo || ok != tt.ok {
+				t.Errorf("Int128() = %#x, %#x, %v, want %#x, %#x, %v", hi, lo, ok, tt.hi, tt.lo, tt.ok)
+			}
+			if got := FromInt128(hi, lo); ok && !same(got, tt.arg) {
+				t.Errorf("FromInt128() = %#v, want %#v", got, tt.arg)
+			}
+		})
+	}
+}
+
+func TestFromInt128_big(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 2000 {
+		hi, lo := r.Int63()>>r.Intn(64), r.Uint64()
+		if r.Intn(2) == 0 {
+			hi = ^hi
+		}
+		if r.Intn(4) == 0 {
+			// Close to a tie.
+			lo = lo>>20<<20 | 1<<19 - uint64(r.Intn(3)) + 1
+		}
+
+		var b big.Int
+		b.SetInt64(hi).Lsh(&b, 64).Or(&b, new(big.Int).SetUint64(lo))
+		want := FromBigInt(&b)
+		got := FromInt128(hi, lo)
+		if !same(got, want) {
+			t.Fatalf("FromInt128(%#x, %#x) = %#v, want %#v", hi, lo, got, want)
+		}
+
+		// Integers that don't round trip through FromInt128
+		// still convert exactly.
+		n := Make(want.y, math.Trunc(math.Ldexp(r.Float64()-0.5, r.Intn(60))))
+		if h, l, ok := n.Int128(); ok {
+			b.SetInt64(h).Lsh(&b, 64).Or(&b, new(big.Int).SetUint64(l))
+			if want, _ := n.BigInt(nil); b.Cmp(want) != 0 {
+				t.Fatalf("%#v.Int128() = %#x, %#x, want %v", n, h, l, want)
+			}
+		}
+	}
+}
@@ -37,31 +37,33 @@ func IsInf(n Number, sign int) bool {
 func Trunc(n Number) Number {
 	y := math.Trunc(n.y)
 	switch {
-	case y != n.y:
+	case y != n.y || n.x == 0:
 		return Number{y: y}
 	case y < 0:
-		return Number{y: y, x: math.Ceil(n.x)}
+		return Ceil(n)
 	default:
-		return Number{y: y, x: math.Floor(n.x)}
+		return Floor(n)
 	}
 }
 
 // Floor returns the greatest integer value less than or equal to n (exact).
 func Floor(n Number) Number {
 	y := math.Floor(n.y)
-	if y != n.y {
+	if y != n.y || n.x == 0 {
 		return Number{y: y}
 	}
-	return Number{y, math.Floor(n.x)}
+	return twoSumQuick(y, math.Floor(n.x))
 }
 
 // Ceil returns the least integer value greater than or equal to n (exact).
 func Ceil(n Number) Number {
 	y := math.Ceil(n.y)
-	if y != n.y {
+	if y != n.y || n.x == 0 {
 		return Number{y: y}
 	}
-	return Number{y, math.Ceil(n.x)}
+	r := twoSumQuick(y, math.Ceil(n.x))
+	r.y = math.Copysign(r.y, n.y) // keep the sign of -0
+	return r
 }
 
 // Round returns the nearest integer, rounding half away from zero (exact).
@@ -98,7 +100,229 @@ func Ldexp(n Number, i int) Number {
 	if y == 0 || !isFinite(y) {
 		return Number{y: y}
 	}
-	return Number{y, math.Ldexp(n.x, i)}
+	// Renormalize, in case the low part underflowed.
+	return twoSumQuick(y, math.Ldexp(n.x, i))
+}
+
+// Frexp breaks n into a normalized fraction and an integral power of two.
+// It returns frac and exp satisfying n == frac × 2**exp,
+// with the absolute value of frac in the interval [½, 1).
+//
+// Special cases are:
+//
+//	Frexp(±0) = ±0, 0
+//	Frexp(±Inf) = ±Inf, 0
+//	Frexp(NaN) = NaN, 0
+func Frexp(n Number) (frac Number, exp int) {
+	if n.y == 0 || !isFinite(n.y) {
+		return n, 0
+	}
+	_, exp = math.Frexp(n.y)
+	// If the high part is a power of two,
+	// and the low part has the opposite sign,
+	// n is in the binade below that of the high part.
+	if n.x != 0 && (n.x < 0) != (n.y < 0) && math.Abs(n.y) == math.Ldexp(0.5, exp) {
+		exp--
+	}
+	frac = Ldexp(n, -exp)
+	if math.Abs(frac.y) == 1 && frac.x == 0 {
+		// The low part underflowed: keep frac below 1.
+		frac.x = math.Copysign(math.SmallestNonzeroFloat64, -frac.y)
+	}
+	return frac, exp
+}
+
+// Modf returns integer and fractional numbers that sum to n (exact).
+// Both values have the same sign as n.
+//
+// Special cases are:
+//
+//	Modf(±Inf) = ±Inf, NaN
+//	Modf(NaN) = NaN, NaN
+func Modf(n Number) (int Number, frac Number) {
+	if !isFinite(n.y) {
+		if IsNaN(n) {
+			return n, n
+		}
+		return n, NaN()
+	}
+
+	int = Trunc(n)
+	if y := math.Trunc(n.y); y != n.y {
+		frac = AddFloats(n.y-y, n.x)
+	} else if f := n.x - math.Trunc(n.x); f != 0 && (f < 0) != (n.y < 0) {
+		// The low part crosses an integer.
+		frac = AddFloats(math.Copysign(1, n.y), f)
+	} else {
+		frac = Number{y: f}
+	}
+	if frac.y == 0 {
+		frac.y = math.Copysign(0, n.y)
+	}
+	return int, frac
+}
+
+// Nextafter returns the next representable Number after a towards b,
+// on a grid of Numbers with 106-bit significands:
+// it steps a by one double-double ulp, 2⁻¹⁰⁵ times the power of two
+// no greater than |a|, or to the nearest point of that grid.
+//
+// Special cases are:
+//
+//	Nextafter(a, a) = a
+//	Nextafter(±Inf, b) = ±(the largest finite Number)
+//	Nextafter(NaN, b) = NaN
+//	Nextafter(a, NaN) = NaN
+func Nextafter(a, b Number) Number {
+	c := Cmp(a, b)
+	switch {
+	case IsNaN(a) || IsNaN(b):
+		return NaN()
+	case c == 0:
+		return a
+	case IsInf(a, 0):
+		return overflow(a, -c)
+	case a.y == 0:
+		return Number{y: math.Copysign(math.SmallestNonzeroFloat64, float64(-c))}
+	}
+
+	// The grid spacing is 2ᵏ, which halves below a power of two.
+	frac, exp := Frexp(a)
+	k := max(exp-106, -1074)
+	if frac == Float(math.Copysign(0.5, a.y)) && (c > 0) != (a.y < 0) {
+		k = max(k-1, -1074)
+	}
+
+	// Move the low part to the next multiple of 2ᵏ.
+	// The high part is a multiple of 2ᵏ.
+	u := math.Ldexp(1, k)
+	var t float64
+	switch {
+	case math.Abs(a.x) >= u:
+		t = a.x / u
+	case a.x != 0:
+		t = math.Copysign(0.5, a.x)
+	}
+	if c < 0 {
+		t = math.Floor(t) + 1
+	} else {
+		t = math.Ceil(t) - 1
+	}
+
+	r := twoSumQuick(a.y, t*u)
+	if !isFinite(r.y) {
+		return Number{y: r.y}
+	}
+	return r
+}
+
+// Copysign returns a value with the magnitude of n and the sign of sign (exact).
+func Copysign(n, sign Number) Number {
+	if Signbit(n) != Signbit(sign) {
+		return Neg(n)
+	}
+	return n
+}
+
+// Ilogb returns the binary exponent of n as an integer.
+//
+// Special cases are:
+//
+//	Ilogb(±Inf) = MaxInt32
+//	Ilogb(0) = MinInt32
+//	Ilogb(NaN) = MaxInt32
+func Ilogb(n Number) int {
+	switch {
+	case n.y == 0:
+		return math.MinInt32
+	case !isFinite(n.y):
+		return math.MaxInt32
+	}
+	_, exp := Frexp(n)
+	return exp - 1
+}
+
+// Logb returns the binary exponent of n (exact).
+//
+// Special cases are:
+//
+//	Logb(±Inf) = +Inf
+//	Logb(0) = -Inf
+//	Logb(NaN) = NaN
+func Logb(n Number) Number {
+	switch {
+	case n.y == 0:
+		return Inf(-1)
+	case IsInf(n, 0):
+		return Inf(+1)
+	case IsNaN(n):
+		return n
+	}
+	return Float(float64(Ilogb(n)))
+}
+
+// Fdim returns the maximum of a-b or 0 (exactly rounded).
+//
+// Special cases are:
+//
+//	Fdim(+Inf, +Inf) = NaN
+//	Fdim(-Inf, -Inf) = NaN
+//	Fdim(a, NaN) = Fdim(NaN, b) = NaN
+func Fdim(a, b Number) Number {
+	d := Sub(a, b)
+	if d.y <= 0 {
+		return Number{}
+	}
+	return d
+}
+
+// Max returns the larger of a or b (exact).
+//
+// Special cases are:
+//
+//	Max(a, +Inf) = Max(+Inf, b) = +Inf
+//	Max(a, NaN) = Max(NaN, b) = NaN
+//	Max(+0, ±0) = Max(±0, +0) = +0
+//	Max(-0, -0) = -0
+func Max(a, b Number) Number {
+	switch {
+	case IsInf(a, +1) || IsInf(b, +1):
+		return Inf(+1)
+	case IsNaN(a) || IsNaN(b):
+		return NaN()
+	case a.y == 0 && b.y == 0:
+		if Signbit(a) {
+			return b
+		}
+		return a
+	case Cmp(a, b) > 0:
+		return a
+	}
+	return b
+}
+
+// Min returns the smaller of a or b (exact).
+//
+// Special cases are:
+//
+//	Min(a, -Inf) = Min(-Inf, b) = -Inf
+//	Min(a, NaN) = Min(NaN, b) = NaN
+//	Min(-0, ±0) = Min(±0, -0) = -0
+func Min(a, b Number) Number {
+	switch {
+	case IsInf(a, -1) || IsInf(b, -1):
+		return Inf(-1)
+	case IsNaN(a) || IsNaN(b):
+		return NaN()
+	case a.y == 0 && b.y == 0:
+		if Signbit(a) {
+			return a
+		}
+		return b
+	case Cmp(a, b) < 0:
+		return a
+	}
+	return b
 }
 
 // AddFloats returns the sum of a and b (exact).
@@ -262,6 +486,61 @@ func InvSqrt(n Number) Number {
 	return twoSumQuick(y, x)
 }
 
+// Hypot returns Sqrt(a⋅a + b⋅b), taking care to avoid
+// unnecessary overflow and underflow (approximate).
+//
+// Special cases are:
+//
+//	Hypot(±Inf, b) = +Inf
+//	Hypot(a, ±Inf) = +Inf
+//	Hypot(NaN, b) = NaN
+//	Hypot(a, NaN) = NaN
+func Hypot(a, b Number) Number {
+	switch {
+	case IsInf(a, 0) || IsInf(b, 0):
+		return Inf(+1)
+	case IsNaN(a) || IsNaN(b):
+		return NaN()
+	}
+	p := max(math.Abs(a.y), math.Abs(b.y))
+	if p == 0 {
+		return Number{}
+	}
+
+	// Scale the larger argument to [½, 1).
+	_, e := math.Frexp(p)
+	a = Ldexp(a, -e)
+	b = Ldexp(b, -e)
+	return Ldexp(Sqrt(Add(Sqr(a), Sqr(b))), e)
+}
+
+// Hypot3 returns Sqrt(a⋅a + b⋅b + c⋅c), taking care to avoid
+// unnecessary overflow and underflow (approximate).
+//
+// Special cases are:
+//
+//	Hypot3(a, b, c) = +Inf if any argument is ±Inf
+//	Hypot3(a, b, c) = NaN if any argument is NaN, and none is ±Inf
+func Hypot3(a, b, c Number) Number {
+	switch {
+	case IsInf(a, 0) || IsInf(b, 0) || IsInf(c, 0):
+		return Inf(+1)
+	case IsNaN(a) || IsNaN(b) || IsNaN(c):
+		return NaN()
+	}
+	p := max(math.Abs(a.y), math.Abs(b.y), math.Abs(c.y))
+	if p == 0 {
+		return Number{}
+	}
+
+	// Scale the largest argument to [½, 1).
+	_, e := math.Frexp(p)
+	a = Ldexp(a, -e)
+	b = Ldexp(b, -e)
+	c = Ldexp(c, -e)
+	return Ldexp(Sqrt(Add(Add(Sqr(a), Sqr(b)), Sqr(c))), e)
+}
+
 // FMAFloat returns a⋅b + c (exactly rounded).
 func FMAFloat(a, b float64, c Number) Number {
 	s := twoFMA(a, b, c)
@@ -2,6 +2,8 @@ package dbldbl
 
 import (
 	"math"
+	"math/big"
+	"math/rand"
 	"testing"
 )
 
@@ -75,8 +77,14 @@ func TestTrunc(t *testing.T) {
 		{Float(1), Number{1, 0}},
 		{Float(0.5), Number{0, 0}},
 		{Float(1.5), Number{1, 0}},
-		{Number{10, -1.5}, Number{10, -2}},
-		{Number{-10, 1.5}, Number{-10, 2}},
+		{Number{10, -1.5}, Number{8, 0}},
+		{Number{-10, 1.5}, Number{-8, 0}},
+		{Number{-zero, 0}, Number{-zero, 0}},
+		{Float(-0.5), Number{-zero, 0}},
+		{Number{0x1p60, -0.5}, Number{0x1p60, -1}},
+		{Number{-0x1p60, 0.5}, Number{-0x1p60, 1}},
+		{Number{1, -0x1p-60}, Number{0, 0}},
+		{Number{-1, 0x1p-60}, Number{-zero, 0}},
 		{Inf(1), Number{math.Inf(1), 0}},
 		{NaN(), Number{math.NaN(), 0}},
 	}
@@ -98,8 +106,12 @@ func TestFloor(t *testing.T) {
 		{Float(1), Number{1, 0}},
 		{Float(0.5), Number{0, 0}},
 		{Float(1.5), Number{1, 0}},
-		{Number{10, -1.5}, Number{10, -2}},
-		{Number{-10, 1.5}, Number{-10, 1}},
+		{Number{10, -1.5}, Number{8, 0}},
+		{Number{-10, 1.5}, Number{-9, 0}},
+		{Number{-zero, 0}, Number{-zero, 0}},
+		{Number{0x1p60, -0.5}, Number{0x1p60, -1}},
+		{Number{0x1p60, 0.5}, Number{0x1p60, 0}},
+		{Number{1, -0x1p-60}, Number{0, 0}},
 		{Inf(1), Number{math.Inf(1), 0}},
 		{NaN(), Number{math.NaN(), 0}},
 	}
@@ -121,8 +133,13 @@ func TestCeil(t *testing.T) {
 		{Float(1), Number{1, 0}},
 		{Float(0.5), Number{1, 0}},
 		{Float(1.5), Number{2, 0}},
-		{Number{10, -1.5}, Number{10, -1}},
-		{Number{-10, 1.5}, Number{-10, 2}},
+		{Number{10, -1.5}, Number{9, 0}},
+		{Number{-10, 1.5}, Number{-8, 0}},
+		{Number{-zero, 0}, Number{-zero, 0}},
+		{Float(-0.5), Number{-zero, 0}},
+		{Number{0x1p60, -0.5}, Number{0x1p60, 0}},
+		{Number{0x1p60, 0.5}, Number{0x1p60, 1}},
+		{Number{-1, 0x1p-60}, Number{-zero, 0}},
 		{Inf(1), Number{math.Inf(1), 0}},
 		{NaN(), Number{math.NaN(), 0}},
 	}
@@ -172,7 +189,10 @@ func TestLdexp(t *testing.T) {
 		{Number{}, -1, Number{}},
 		{Float(1), +1, Number{2, 0}},
 		{Float(1), -1, Number{0.5, 0}},
-		{Number{-1, 0.5}, 1, Number{-2, 1}},
+		{Number{-1, 0x1p-60}, 1, Number{-2, 0x1p-59}},
+		{Number{1, -0x1p-60}, -1020, Number{0x1p-1020, 0}},
+		{Number{1, 0x1p-53}, -1021, Number{0x1p-1021, 0x1p-1074}},
+		{Number{1 + 0x1p-52, 0x1.8p-54}, -1021, Number{0x1.0000000000002p-1021, -0x1p-1074}},
 		{Float(1), +127, Number{0x1p+127, 0}},
 		{Float(1), -128, Number{0x1p-128, 0}},
 		{Inf(1), +1, Number{math.Inf(1), 0}},
@@ -605,3 +625,318 @@ func TestPolynomial(t *testing.T) {
 		t.Fatalf("got %.5f want %.5f", got.y, want)
 	}
 }
+
+func TestFrexp(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		frac Number
+		exp  int
+	}{
+		{Number{}, Number{}, 0},
+		{Number{-zero, 0}, Number{-zero, 0}, 0},
+		{Float(1), Number{0.5, 0}, 1},
+		{Float(-3), Number{-0.75, 0}, 2},
+		{Number{1, 0x1p-60}, Number{0.5, 0x1p-61}, 1},
+		{Number{1, -0x1p-60}, Number{1, -0x1p-60}, 0},
+		{Number{-1, 0x1p-60}, Number{-1, 0x1p-60}, 0},
+		{Float(0x1p-1074), Number{0.5, 0}, -1073},
+		{Number{0x1p1023, -0x1p-1074}, Number{1, -0x1p-1074}, 1023},
+		{Inf(-1), Number{math.Inf(-1), 0}, 0},
+		{NaN(), Number{math.NaN(), 0}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg.GoString(), func(t *testing.T) {
+			frac, exp := Frexp(tt.arg)
+			if !same(frac, tt.frac) || exp != tt.exp {
+				t.Errorf("Frexp() = %#v, %d, want %#v, %d", frac, exp, tt.frac, tt.exp)
+			}
+		})
+	}
+}
+
+func TestModf(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		int  Number
+		frac Number
+	}{
+		{Number{}, Number{}, Number{}},
+		{Float(-3), Number{-3, 0}, Number{-zero, 0}},
+		{Float(1.5), Number{1, 0}, Number{0.5, 0}},
+		{Number{1.5, 0x1p-60}, Number{1, 0}, Number{0.5, 0x1p-60}},
+		{Number{4, -0.5}, Number{3, 0}, Number{0.5, 0}},
+		{Number{0x1p60, -0x1p-20}, Number{0x1p60, -1}, Number{1 - 0x1p-20, 0}},
+		{Number{-0x1p60, 0x1p-20}, Number{-0x1p60, 1}, Number{-1 + 0x1p-20, 0}},
+		{Number{0x1p60, -0x1p-60}, Number{0x1p60, -1}, Number{1, -0x1p-60}},
+		{Number{0x1p60, 2.5}, Number{0x1p60, 2}, Number{0.5, 0}},
+		{Number{1, -0x1p-60}, Number{0, 0}, Number{1, -0x1p-60}},
+		{Inf(-1), Number{math.Inf(-1), 0}, NaN()},
+		{NaN(), NaN(), NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg.GoString(), func(t *testing.T) {
+			int, frac := Modf(tt.arg)
+			if !same(int, tt.int) || !same(frac, tt.frac) {
+				t.Errorf("Modf() = %#v, %#v, want %#v, %#v", int, frac, tt.int, tt.frac)
+			}
+		})
+	}
+}
+
+func TestNextafter(t *testing.T) {
+	max := Number{math.MaxFloat64, 0x1p970 - 0x1p917}
+	tests := []struct {
+		arg1 Number
+		arg2 Number
+		want Number
+	}{
+		{Float(1), Float(2), Number{1, 0x1p-105}},
+		{Float(1), Float(0), Number{1, -0x1p-106}},
+		{Float(-1), Float(0), Number{-1, 0x1p-106}},
+		{Float(-1), Float(-2), Number{-1, -0x1p-105}},
+		{Number{1, -0x1p-106}, Float(2), Float(1)},
+		{Number{1, 0x1p-105}, Float(0), Float(1)},
+		{Number{1, 0x1p-200}, Float(2), Number{1, 0x1p-105}},
+		{Number{1, 0x1p-200}, Float(0), Float(1)},
+		{Number{1, -0x1p-200}, Float(0), Number{1, -0x1p-106}},
+		{Number{3, 0x1p-52}, Float(0), Number{3, 0x1p-52 - 0x1p-104}},
+		{Float(0), Float(1), Float(0x1p-1074)},
+		{Float(0), Float(-1), Float(-0x1p-1074)},
+		{Float(0x1p-1074), Float(0), Float(0)},
+		{Float(0x1p-1000), Float(1), Number{0x1p-1000, 0x1p-1074}},
+		{Float(0x1p-1000), Float(0), Number{0x1p-1000, -0x1p-1074}},
+		{max, Inf(1), Inf(1)},
+		{max, Float(0), Number{math.MaxFloat64, 0x1p970 - 0x1p918}},
+		{Inf(1), Float(0), max},
+		{Float(1), Float(1), Float(1)},
+		{Float(0), Float(-zero), Float(0)},
+		{NaN(), Float(1), NaN()},
+		{Float(1), NaN(), NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg1.GoString(), func(t *testing.T) {
+			if got := Nextafter(tt.arg1, tt.arg2); !same(got, tt.want) {
+				t.Errorf("Nextafter() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextafter_big(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 2000 {
+		a := Make(math.Ldexp(r.Float64()-0.5, r.Intn(2000)-1000), math.Ldexp(r.Float64()-0.5, r.Intn(100)-1100))
+		if r.Intn(2) == 0 {
+			a = Make(a.y, math.Ldexp(math.Trunc(a.x*0x1p30), -1100))
+		}
+		frac, exp := Frexp(a)
+		if !IsCanonical(frac) || math.Abs(frac.y) < 0.5 || math.Abs(frac.y) > 1 ||
+			Abs(frac) == Float(1) {
+			t.Fatalf("Frexp(%#v) = %#v, %d", a, frac, exp)
+		}
+		// Exact, unless the low part underflows.
+		if math.Abs(a.x) >= math.Ldexp(0x1p-1022, exp) && Ldexp(frac, exp).Rat().Cmp(a.Rat()) != 0 {
+			t.Fatalf("Frexp(%#v) = %#v, %d", a, frac, exp)
+		}
+		int, fr := Modf(a)
+		if !IsCanonical(fr) || new(big.Rat).Add(int.Rat(), fr.Rat()).Cmp(a.Rat()) != 0 {
+			t.Fatalf("Modf(%#v) = %#v, %#v", a, int, fr)
+		}
+
+		up, down := Nextafter(a, Inf(1)), Nextafter(a, Inf(-1))
+		if !IsCanonical(up) || !IsCanonical(down) || Cmp(down, a) >= 0 || Cmp(up, a) <= 0 {
+			t.Fatalf("Nextafter(%#v) = %#v, %#v", a, down, up)
+		}
+		// Stepping back lands on the grid,
+		// and steps through grid points are reversible.
+		if b := Nextafter(up, Inf(-1)); Cmp(b, a) > 0 || !same(Nextafter(b, Inf(1)), up) {
+			t.Fatalf("Nextafter(%#v) = %#v, %#v", a, up, b)
+		}
+		if b := Nextafter(down, Inf(1)); Cmp(b, a) < 0 || !same(Nextafter(b, Inf(-1)), down) {
+			t.Fatalf("Nextafter(%#v) = %#v, %#v", a, down, b)
+		}
+	}
+}
+
+func TestCopysign(t *testing.T) {
+	tests := []struct {
+		arg1 Number
+		arg2 Number
+		want Number
+	}{
+		{Number{1, -0x1p-60}, Float(-2), Number{-1, 0x1p-60}},
+		{Number{-1, 0x1p-60}, Float(-2), Number{-1, 0x1p-60}},
+		{Number{-1, 0x1p-60}, Float(0), Number{1, -0x1p-60}},
+		{Float(1), Float(-zero), Number{-1, 0}},
+		{Inf(1), Float(-1), Number{math.Inf(-1), 0}},
+		{NaN(), Float(1), NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg1.GoString(), func(t *testing.T) {
+			if got := Copysign(tt.arg1, tt.arg2); !same(got, tt.want) {
+				t.Errorf("Copysign() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIlogb(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want int
+	}{
+		{Float(1), 0},
+		{Float(-3), 1},
+		{Number{1, -0x1p-60}, -1},
+		{Number{-1, 0x1p-60}, -1},
+		{Number{1, 0x1p-60}, 0},
+		{Float(0x1p-1074), -1074},
+		{Float(math.MaxFloat64), 1023},
+		{Float(0), math.MinInt32},
+		{Inf(-1), math.MaxInt32},
+		{NaN(), math.MaxInt32},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg.GoString(), func(t *testing.T) {
+			if got := Ilogb(tt.arg); got != tt.want {
+				t.Errorf("Ilogb() = %d, want %d", got, tt.want)
+			}
+			want := Float(float64(tt.want))
+			switch {
+			case tt.arg.y == 0:
+				want = Inf(-1)
+			case IsInf(tt.arg, 0):
+				want = Inf(1)
+			case IsNaN(tt.arg):
+				want = NaN()
+			}
+			if got := Logb(tt.arg); !same(got, want) {
+				t.Errorf("Logb() = %#v, want %#v", got, want)
+			}
+		})
+	}
+}
+
+func TestFdim(t *testing.T) {
+	tests := []struct {
+		arg1 Number
+		arg2 Number
+		want Number
+	}{
+		{Float(3), Float(1), Number{2, 0}},
+		{Float(1), Float(3), Number{}},
+		{Number{1, 0x1p-60}, Float(1), Number{0x1p-60, 0}},
+		{Number{1, -0x1p-60}, Float(1), Number{}},
+		{Float(-zero), Float(0), Number{}},
+		{Inf(1), Inf(1), NaN()},
+		{Inf(-1), Inf(-1), NaN()},
+		{Inf(1), Float(1), Inf(1)},
+		{Float(1), NaN(), NaN()},
+		{NaN(), Float(1), NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg1.GoString(), func(t *testing.T) {
+			if got := Fdim(tt.arg1, tt.arg2); !same(got, tt.want) {
+				t.Errorf("Fdim() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	tests := []struct {
+		arg1     Number
+		arg2     Number
+		min, max Number
+	}{
+		{Float(1), Float(2), Float(1), Float(2)},
+		{Number{1, 0x1p-60}, Number{1, -0x1p-60}, Number{1, -0x1p-60}, Number{1, 0x1p-60}},
+		{Float(0), Float(-zero), Float(-zero), Float(0)},
+		{Float(-zero), Float(0), Float(-zero), Float(0)},
+		{Float(-zero), Float(-zero), Float(-zero), Float(-zero)},
+		{Inf(1), NaN(), NaN(), Inf(1)},
+		{NaN(), Inf(-1), Inf(-1), NaN()},
+		{Float(1), NaN(), NaN(), NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg1.GoString(), func(t *testing.T) {
+			if got := Min(tt.arg1, tt.arg2); !same(got, tt.min) {
+				t.Errorf("Min() = %#v, want %#v", got, tt.min)
+			}
+			if got := Max(tt.arg1, tt.arg2); !same(got, tt.max) {
+				t.Errorf("Max() = %#v, want %#v", got, tt.max)
+			}
+		})
+	}
+}
+
+func TestHypot(t *testing.T) {
+	tests := []struct {
+		arg1 Number
+		arg2 Number
+		want Number
+	}{
+		{Float(3), Float(4), Number{5, 0}},
+		{Float(-3), Float(-4), Number{5, 0}},
+		{Float(0x1p1000 * 3), Float(0x1p1000 * 4), Number{0x1p1000 * 5, 0}},
+		{Float(0x1p-1070 * 3), Float(0x1p-1070 * 4), Number{0x1p-1070 * 5, 0}},
+		{Float(math.MaxFloat64), Float(math.MaxFloat64), Inf(1)},
+		{Float(1), Float(0x1p-1074), Number{1, 0}},
+		{Float(1), Float(1), Sqrt(Float(2))},
+		{Float(0), Float(-zero), Number{}},
+		{Inf(-1), NaN(), Inf(1)},
+		{NaN(), Inf(1), Inf(1)},
+		{Float(1), NaN(), NaN()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg1.GoString(), func(t *testing.T) {
+			if got := Hypot(tt.arg1, tt.arg2); !same(got, tt.want) {
+				t.Errorf("Hypot() = %#v, want %#v", got, tt.want)
+			}
+			if got := Hypot3(tt.arg1, Float(0), tt.arg2); !same(got, tt.want) {
+				t.Errorf("Hypot3() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+
+	if got := Hypot3(Float(2), Float(-3), Float(6)); !same(got, Float(7)) {
+		t.Errorf("Hypot3() = %#v, want %#v", got, 7)
+	}
+	if got := Hypot3(Float(1), NaN(), Inf(-1)); !same(got, Inf(1)) {
+		t.Errorf("Hypot3() = %#v, want %#v", got, Inf(1))
+	}
+}
+
+func TestHypot_big(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	random := func(e int) Number {
+		return Make(math.Ldexp(r.Float64()-0.5, e), math.Ldexp(r.Float64()-0.5, e-54))
+	}
+
+	for range 2000 {
+		e := r.Intn(2000) - 1000
+		a, b, c := random(e), random(e-r.Intn(60)), random(e-r.Intn(60))
+
+		var x, y big.Float
+		x.SetPrec(300)
+		y.SetPrec(300)
+		x.Mul(a.toBig(), a.toBig())
+		x.Add(&x, y.Mul(b.toBig(), b.toBig()))
+		want := new(big.Float).SetPrec(300).Sqrt(&x)
+		x.Add(&x, y.Mul(c.toBig(), c.toBig()))
+		want3 := new(big.Float).SetPrec(300).Sqrt(&x)
+
+		// |got - want| ≤ 2⁻¹⁰⁴⋅want + 2⁻¹⁰⁷³
+		check := func(name string, got Number, want *big.Float) {
+			t.Helper()
+			var d big.Float
+			d.SetPrec(300).Sub(got.toBig(), want).Abs(&d)
+			y.SetMantExp(want, -104).Add(&y, big.NewFloat(0x1p-1073))
+			if d.Cmp(&y) > 0 {
+				t.Fatalf("%s(%#v, %#v, %#v) = %#v, want %v", name, a, b, c, got, want)
+			}
+		}
+		check("Hypot", Hypot(a, b), want)
+		check("Hypot3", Hypot3(a, b, c), want3)
+	}
+}
@@ -0,0 +1,338 @@
+package dbldbl
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Parse converts the string s to a Number.
+//
+// Parse accepts decimal and hexadecimal floating-point numbers
+// as defined by the Go syntax for floating-point literals,
+// the strings accepted by [strconv.ParseFloat] for infinities and NaN,
+// and the Number{hi, lo} form produced by [Number.GoString].
+//
+// The result is the double-double nearest to the exact value of s:
+// its high part is the float64 nearest to that value,
+// and its low part is the float64 nearest to the remainder.
+//
+// The errors that Parse returns have concrete type [*strconv.NumError].
+// If s is not syntactically well-formed, Parse returns err.Err = [strconv.ErrSyntax].
+// If s is well-formed but its magnitude is too large to be represented,
+// Parse returns ±Inf and err.Err = [strconv.ErrRange].
+func Parse(s string) (Number, error) {
+	f, err := strconv.ParseFloat(s, 64)
+	switch {
+	case err != nil && err.(*strconv.NumError).Err == strconv.ErrSyntax:
+		if t, ok := strings.CutPrefix(s, "Number{"); ok {
+			return parseGoString(s, t)
+		}
+		return Number{}, syntaxError(s)
+	case !isFinite(f):
+		if err == nil {
+			return Number{y: f}, nil
+		}
+	}
+
+	n := parseFloat(s)
+	if !isFinite(n.y) {
+		return n, rangeError(s)
+	}
+	return n, nil
+}
+
+func parseGoString(s, t string) (Number, error) {
+	t, ok := strings.CutSuffix(t, "}")
+	if !ok {
+		return Number{}, syntaxError(s)
+	}
+	y, x, ok := strings.Cut(t, ",")
+	if !ok {
+		return Number{}, syntaxError(s)
+	}
+	hi, herr := strconv.ParseFloat(strings.TrimSpace(y), 64)
+	lo, lerr := strconv.ParseFloat(strings.TrimSpace(x), 64)
+	for _, err := range [2]error{herr, lerr} {
+		if err != nil && err.(*strconv.NumError).Err != strconv.ErrRange {
+			return Number{}, syntaxError(s)
+		}
+	}
+	n := AddFloats(hi, lo)
+	if herr != nil || lerr != nil {
+		return n, rangeError(s)
+	}
+	return n, nil
+}
+
+// Scan implements [fmt.Scanner].
+//
+// It accepts the verbs for floating-point numbers:
+// 'b', 'e', 'E', 'f', 'F', 'g', 'G', 'x', 'X' and 'v',
+// and the same syntax as for a float64, including the
+// non-standard decimal mantissa with a binary exponent (1.2p4).
+// Scan consumes only the characters that belong to the number,
+// which is then converted as if by [Parse].
+func (n *Number) Scan(state fmt.ScanState, verb rune) error {
+	switch verb {
+	case 'b', 'e', 'E', 'f', 'F', 'g', 'G', 'x', 'X', 'v':
+	default:
+		return fmt.Errorf("bad verb '%%%c' for dbldbl.Number", verb)
+	}
+
+	state.SkipSpace()
+	if _, _, err := state.ReadRune(); err != nil {
+		return io.ErrUnexpectedEOF
+	}
+	state.UnreadRune()
+	s := scanToken(state)
+
+	var err error
+	if i := strings.LastIndexAny(s, "pP"); i >= 0 && !strings.ContainsAny(s, "xX") {
+		// Scale a decimal mantissa by a power of two.
+		*n, err = Parse(s[:i])
+		if err == nil {
+			*n, err = scaleExp(*n, s[i+1:])
+		}
+	} else {
+		*n, err = Parse(s)
+	}
+	if err, ok := err.(*strconv.NumError); ok {
+		err.Num = s
+	}
+	return err
+}
+
+// scaleExp returns n⋅2ᵖ, for the binary exponent p in s,
+// with errors like those returned by [Parse].
+func scaleExp(n Number, s string) (Number, error) {
+	p, err := strconv.Atoi(s)
+	if err != nil {
+		if err.(*strconv.NumError).Err != strconv.ErrRange {
+			return Number{}, syntaxError(s)
+		}
+		// Past this, any nonzero n overflows or underflows.
+		p = max(-1<<20, min(p, 1<<20))
+	}
+	n = Ldexp(n, p)
+	if !isFinite(n.y) {
+		return n, rangeError(s)
+	}
+	return n, nil
+}
+
+// scanToken reads the longest prefix that could be a floating-point number,
+// following the rules of the float64 scanner.
+func scanToken(state fmt.ScanState) string {
+	var buf []byte
+	accept := func(ok string) bool {
+		r, _, err := state.ReadRune()
+		if err != nil {
+			return false
+		}
+		if strings.ContainsRune(ok, r) {
+			buf = append(buf, byte(r))
+			return true
+		}
+		state.UnreadRune()
+		return false
+	}
+
+	// NaN?
+	if accept("nN") && accept("aA") && accept("nN") {
+		return string(buf)
+	}
+	// leading sign?
+	accept("+-")
+	// Inf?
+	if accept("iI") && accept("nN") && accept("fF") {
+		return string(buf)
+	}
+	digits := "0123456789_"
+	exp := "eEpP"
+	if accept("0") && accept("xX") {
+		digits = "0123456789aAbBcCdDeEfF_"
+		exp = "pP"
+	}
+	for accept(digits) {
+	}
+	if accept(".") {
+		for accept(digits) {
+		}
+	}
+	if accept(exp) {
+		accept("+-")
+		for accept("0123456789_") {
+		}
+	}
+	return string(buf)
+}
+
+// Enough significant digits to hold any double-double rounding midpoint,
+// the smallest of which are multiples of 2⁻¹⁰⁷⁵ below 2¹⁰²⁴.
+const maxParseDigits = 1500
+
+// parseFloat assumes s was already validated by strconv.ParseFloat.
+func parseFloat(s string) Number {
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		fallthrough
+	case '+':
+		s = s[1:]
+	}
+
+	base := 10
+	expChar := byte('e')
+	if len(s) > 2 && s[0] == '0' && lower(s[1]) == 'x' {
+		base = 16
+		expChar = 'p'
+		s = s[2:]
+	}
+
+	// Significant digits, without leading zeros,
+	// and the position of the radix point relative to them.
+	var digits []byte
+	var dp int
+	var sawdot, trunc bool
+	var i int
+	for ; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '_':
+			continue
+		case c == '.':
+			sawdot = true
+			continue
+		case '0' <= c && c <= '9' || base == 16 && 'a' <= lower(c) && lower(c) <= 'f':
+			if c == '0' && len(digits) == 0 {
+				if sawdot {
+					dp--
+				}
+				continue
+			}
+			if !sawdot {
+				dp++
+			}
+			if len(digits) < maxParseDigits {
+				digits = append(digits, c)
+			} else if c != '0' {
+				trunc = true
+			}
+			continue
+		}
+		break
+	}
+
+	var exp int
+	if i < len(s) && lower(s[i]) == expChar {
+		i++
+		esign := 1
+		switch s[i] {
+		case '-':
+			esign = -1
+			fallthrough
+		case '+':
+			i++
+		}
+		for ; i < len(s); i++ {
+			if c := s[i]; c != '_' && exp < 1<<30 {
+				exp = exp*10 + int(c-'0')
+			}
+		}
+		exp *= esign
+	}
+
+	var n Number
+	if len(digits) > 0 {
+		if trunc {
+			// Sticky digit: any nonzero digit beyond the
+			// ones kept rounds the same way.
+			digits = append(digits, '1')
+		}
+		n = parseDigits(string(digits), base, dp-len(digits), exp)
+	}
+	if neg {
+		return Neg(n)
+	}
+	return n
+}
+
+// parseDigits returns the Number nearest to m⋅10ᵉ⁺ᵖ (base 10),
+// or m⋅16ᵉ⋅2ᵖ (base 16), where m is the integer with the given digits.
+func parseDigits(digits string, base, e, p int) Number {
+	var num, den big.Int
+	num.SetString(digits, base)
+
+	if base == 10 {
+		e += p
+		// 10ⁿ⁻¹ ≤ m⋅10ᵉ < 10ⁿ, n = len(digits)+e
+		switch n := len(digits) + e; {
+		case n > 310:
+			return Inf(+1)
+		case n < -324:
+			return Number{}
+		}
+		den.Exp(big.NewInt(10), big.NewInt(int64(abs(e))), nil)
+		if e > 0 {
+			num.Mul(&num, &den)
+			den.SetInt64(1)
+		}
+	} else {
+		e = 4*e + p
+		// 2ⁿ⁻¹ ≤ m⋅2ᵉ < 2ⁿ, n = bitlen(m)+e
+		switch n := num.BitLen() + e; {
+		case n > 1025:
+			return Inf(+1)
+		case n < -1075:
+			return Number{}
+		}
+		den.SetInt64(1)
+		if e > 0 {
+			num.Lsh(&num, uint(e))
+		} else {
+			den.Lsh(&den, uint(-e))
+		}
+	}
+
+	var r big.Rat
+	return FromRat(r.SetFrac(&num, &den))
+}
+
+// renormalize returns the Number for y + x,
+// where y is the float64 nearest to some value,
+// and x is the float64 nearest to the remainder.
+func renormalize(y, x float64) Number {
+	if x == 0 {
+		return Number{y: y}
+	}
+	// Renormalize, in case x rounded to a tie,
+	// which may overflow just below ±Inf.
+	n := twoSumQuick(y, x)
+	if !isFinite(n.y) {
+		return Number{y: n.y}
+	}
+	return n
+}
+
+func syntaxError(s string) error {
+	return &strconv.NumError{Func: "ParseFloat", Num: s, Err: strconv.ErrSyntax}
+}
+
+func rangeError(s string) error {
+	return &strconv.NumError{Func: "ParseFloat", Num: s, Err: strconv.ErrRange}
+}
+
+func lower(c byte) byte {
+	return c | ('x' - 'X')
+}
+
+func abs(i int) int {
+	if i < 0 {
+		return -i
+	}
+	return i
+}
@@ -0,0 +1,195 @@
+package dbldbl
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want Number
+	}{
+		{"0", Number{}},
+		{"-0", Number{-zero, 0}},
+		{"+1", Number{1, 0}},
+		{"1_000", Number{1000, 0}},
+		{"0.1", parse("0.1")},
+		{"-.1e1", Number{-1, 0}},
+		{"1e-400", Number{}},
+		{"-1e-400", Number{-zero, 0}},
+		{"0x1.8p1", Number{3, 0}},
+		{"0X_1P-1074", Number{math.SmallestNonzeroFloat64, 0}},
+		{"3.141592653589793238462643383279502884197169399375105820", Pi},
+		{"2.71828182845904523536028747135266249775724709369995957496", E},
+		{"0x1.fffffffffffffp1023", Number{math.MaxFloat64, 0}},
+		{"NaN", NaN()},
+		{"-Inf", Inf(-1)},
+		{"+infinity", Inf(+1)},
+		{"0x1.00000000000000000000000000000000001p0", Number{1, 0x1p-140}},
+		{"1.00000000000000011102230246251565404236316680908203125", Number{1, 0x1p-53}},
+		{"1.0000000000000003330669073875469621270895004272460937500", Number{1 + 0x1p-51, -0x1p-53}},
+		{"0x1.00000000000017fffffffffffffp0", Number{1 + 0x1p-51, -0x1p-53}},
+		{"0x1.00000000000008p-1022", Number{0x1p-1022, 0}},
+		{"0x1.0000000000000cp-1022", Number{0x1p-1022 + 0x1p-1074, 0}},
+		{"Number{3.141592653589793, +0x1.1a62633145c07p-53}", Pi},
+		{"Number{1, -0x1p-60}", Number{1, -0x1p-60}},
+		{"Number{NaN, +0x0p+00}", NaN()},
+		{"1" + strings.Repeat("0", 2000) + "e-2000", Number{1, 0}},
+		{"1.00000000000000011102230246251565404236316680908203125" + strings.Repeat("0", 2000) + "1", Number{1, 0x1p-53}},
+		{exact(1, 0x1p-60, 0x1p-113), Number{1, 0x1p-60}},
+		{exact(1, 0x1p-60, 0x1p-113) + strings.Repeat("0", 2000) + "1", Number{1, 0x1p-60 + 0x1p-112}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			got, err := Parse(tt.arg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !same(got, tt.want) {
+				t.Errorf("Parse() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func exact(f ...float64) string {
+	var sum big.Float
+	for _, f := range f {
+		sum.Add(&sum, new(big.Float).SetPrec(2000).SetFloat64(f))
+	}
+	return sum.Text('f', 200)
+}
+
+func TestParse_errors(t *testing.T) {
+	tests := []struct {
+		arg  string
+		want Number
+		err  error
+	}{
+		{"", Number{}, strconv.ErrSyntax},
+		{" 1", Number{}, strconv.ErrSyntax},
+		{"1e", Number{}, strconv.ErrSyntax},
+		{"0x1", Number{}, strconv.ErrSyntax},
+		{"1__0", Number{}, strconv.ErrSyntax},
+		{"+NaN", Number{}, strconv.ErrSyntax},
+		{"Number{1}", Number{}, strconv.ErrSyntax},
+		{"Number{1, x}", Number{}, strconv.ErrSyntax},
+		{"Number{1, 2", Number{}, strconv.ErrSyntax},
+		{"1e400", Inf(+1), strconv.ErrRange},
+		{"-0x1p1024", Inf(-1), strconv.ErrRange},
+		{"1.7976931348623159e308", Inf(+1), strconv.ErrRange},
+		{"0x1.fffffffffffff7fffffffffffffffffp1023", Inf(+1), strconv.ErrRange},
+		{"Number{1e400, 0}", Inf(+1), strconv.ErrRange},
+		{"Number{-1e400, 0}", Inf(-1), strconv.ErrRange},
+		{"Number{1, 1e400}", Inf(+1), strconv.ErrRange},
+		{"Number{1e400, x}", Number{}, strconv.ErrSyntax},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			got, err := Parse(tt.arg)
+			if !errors.Is(err, tt.err) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.err)
+			}
+			if !same(got, tt.want) {
+				t.Errorf("Parse() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_GoString(t *testing.T) {
+	for _, n := range []Number{E, Pi, Phi, Sqrt2, Ln2, Ln10, Neg(Pi), Inf(1), Inf(-1)} {
+		if got, err := Parse(n.GoString()); err != nil || !same(got, n) {
+			t.Errorf("Parse(%q) = %#v, want %#v", n.GoString(), got, n)
+		}
+	}
+}
+
+func TestNumber_Scan(t *testing.T) {
+	tests := []struct {
+		format string
+		input  string
+		want   Number
+		rest   string
+	}{
+		{"%v", "0.1", parse("0.1"), ""},
+		{"%v", " -1.5e1 ", Number{-15, 0}, " "},
+		{"%g", "3.141592653589793238462643383279502884197169399375105820", Pi, ""},
+		{"%e", "1e-400x", Number{}, "x"},
+		{"%f", "1_000.5,", Number{1000.5, 0}, ","},
+		{"%x", "0x1.8p1;", Number{3, 0}, ";"},
+		{"%X", "-0X1P-1074", Number{-math.SmallestNonzeroFloat64, 0}, ""},
+		{"%b", "3p-1", Number{1.5, 0}, ""},
+		{"%v", "1.00000000000000011102230246251565404236316680908203125p1", Number{2, 0x1p-52}, ""},
+		{"%v", "-1p-99999999999999999999", Number{-zero, 0}, ""},
+		{"%v", "nan)", NaN(), ")"},
+		{"%v", "-Infinity", Inf(-1), "inity"},
+		{"%v", "+inf+", Inf(+1), "+"},
+		{"%5v", "123456", Number{12345, 0}, "6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var got Number
+			var rest string
+			n, err := fmt.Sscanf(tt.input, tt.format+"%s", &got, &rest)
+			if n < 1 {
+				t.Fatal(err)
+			}
+			if !same(got, tt.want) {
+				t.Errorf("Scan() = %#v, want %#v", got, tt.want)
+			}
+			if rest != strings.TrimSpace(tt.rest) {
+				t.Errorf("Scan() left %q, want %q", rest, tt.rest)
+			}
+		})
+	}
+}
+
+func TestNumber_Scan_errors(t *testing.T) {
+	tests := []struct {
+		format string
+		input  string
+		err    error
+	}{
+		{"%v", "", io.ErrUnexpectedEOF},
+		{"%v", " ", io.ErrUnexpectedEOF},
+		{"%v", "x", strconv.ErrSyntax},
+		{"%v", "1e", strconv.ErrSyntax},
+		{"%v", "1e400", strconv.ErrRange},
+		{"%v", "1p", strconv.ErrSyntax},
+		{"%v", "1p2000", strconv.ErrRange},
+		{"%v", "1p99999999999999999999", strconv.ErrRange},
+		{"%d", "1", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			var got Number
+			_, err := fmt.Sscanf(tt.input, tt.format, &got)
+			if err == nil || tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("Scan() error = %v, want %v", err, tt.err)
+			}
+			if err, ok := err.(*strconv.NumError); ok && (err.Func != "ParseFloat" || !strings.HasPrefix(tt.input, err.Num)) {
+				t.Errorf("Scan() error = %#v", err)
+			}
+		})
+	}
+}
+
+func TestNumber_Scan_fields(t *testing.T) {
+	var a, b Number
+	var s string
+	n, err := fmt.Sscan("3.141592653589793238462643383279502884197169399375105820 -0x1p-60 end", &a, &b, &s)
+	if n != 3 || err != nil {
+		t.Fatal(n, err)
+	}
+	if !same(a, Pi) || !same(b, Number{-0x1p-60, 0}) || s != "end" {
+		t.Errorf("Sscan() = %#v, %#v, %q", a, b, s)
+	}
+}
@@ -1,8 +1,12 @@
 package dbldbl
 
-import "math"
+import (
+	"math"
+	"math/big"
+)
 
 // Pow returns bⁿ, the base-b exponential of n (approximate).
+// Unless the result is subnormal, or n is ±½, its relative error is less than 2⁻¹⁰⁴.
 func Pow(b Number, n Number) Number {
 	switch {
 	case n.y == 0 || b == Float(1):
@@ -47,39 +51,165 @@ func Pow(b Number, n Number) Number {
 	case n == Float(-0.5):
 		return InvSqrt(b)
 	case n == Floor(n):
-		return pow(b, n)
+		if i, acc := n.Int64(big.ToZero); acc == big.Exact {
+			return PowInt(b, i)
+		}
+		r := powExt(Abs(b), n)
+		if isOddInteger(n) && Signbit(b) {
+			return Neg(r)
+		}
+		return r
 	case b.y < 0:
 		return NaN()
 	}
 
-	return Exp(Mul(Log(b), n))
+	return powExt(b, n)
 }
 
-// Pow10 returns 10ⁱ, the base-10 exponential of i (approximate).
-func Pow10(i int) Number {
-	return pow(Float(10), Int(int64(i)))
-}
+// PowInt returns bⁿ, the base-b exponential of the integer n (approximate).
+// Unless the result is subnormal, its relative error is less than 2⁻¹⁰⁴.
+func PowInt(b Number, n int64) Number {
+	switch {
+	case n == 0:
+		return Float(1)
+	case n == 1:
+		return b
+	case b.y == 0 || !isFinite(b.y):
+		return Pow(b, Int(n))
+	}
 
-func pow(b Number, n Number) Number {
-	r := Float(1)
-	i := Abs(n)
-	for {
-		if isOddInteger(i) {
-			i = twoSumQuick(i.y, i.x-1)
-			r = Mul(r, b)
+	u := uint64(n)
+	if n < 0 {
+		u = -u
+	}
+
+	var r Number
+	if u > 1<<50 {
+		// The error of binary powering grows with n, and for such n
+		// the result overflows or underflows, unless b is close to 1.
+		r = powExt(Abs(b), Int(n))
+	} else {
+		// Binary powering, in extended precision, with the
+		// mantissas kept in [½, 1) and the exponents apart,
+		// so intermediate results don't overflow or underflow.
+		x, xl, xe := frexpExt(Abs(b), 0)
+		p, pl, pe := Float(1), 0.0, 0
+		for {
+			if u&1 != 0 {
+				var e int
+				p, pl = mulExt(p, pl, x, xl)
+				p, pl, e = frexpExt(p, pl)
+				pe += xe + e
+			}
+			if u >>= 1; u == 0 {
+				break
+			}
+			var e int
+			x, xl = mulExt(x, xl, x, xl)
+			x, xl, e = frexpExt(x, xl)
+			xe += xe + e
+			// Past this, the result overflows or underflows.
+			if xe > 1<<20 || xe < -1<<20 {
+				xe = max(-1<<20, min(xe, 1<<20))
+				pe = max(-1<<20, min(pe, 1<<20))
+			}
 		}
-		if i.y == 0 {
-			break
+		if n < 0 {
+			p, pl = divExt(Float(1), 0, p, pl)
+			pe = -pe
 		}
-		i = shift(i, -1)
-		b = Sqr(b)
+		r = Ldexp(AddFloat(p, pl), pe)
 	}
-	if n.y < 0 {
-		return Inv(r)
+
+	if n&1 != 0 && Signbit(b) {
+		return Neg(r)
 	}
 	return r
 }
 
+// Pow10 returns 10ⁱ, the base-10 exponential of i (approximate).
+func Pow10(i int) Number {
+	return PowInt(Float(10), int64(i))
+}
+
+// The extended precision functions below represent numbers as the
+// unevaluated sum of a Number and a float64 smaller than its low part,
+// for about 150 bits of precision.
+
+// ln2 to extended precision.
+const (
+	ln2Hi  = 0.6931471805599453
+	ln2Mid = 0x1.abc9e3b39803fp-56
+	ln2Lo  = 0x1.7b57a079a1934p-111
+)
+
+// powExt returns bⁿ, for finite b > 0, through log and exp in extended precision.
+func powExt(b, n Number) Number {
+	l, ll := logExt(b)
+	return expExt(mulExt(n, 0, l, ll))
+}
+
+// logExt returns log(b), for finite b > 0, in extended precision.
+func logExt(b Number) (Number, float64) {
+	// b = m⋅2ᵏ, with m in [¾, 1½).
+	m, k := Frexp(b)
+	if m.y < 0.75 {
+		m = shift(m, 1)
+		k--
+	}
+	return logReduced(twoSum(m.y-1, m.x), k, true)
+}
+
+// expExt returns eⁿ⁺ⁿˡ (approximate).
+func expExt(n Number, nl float64) Number {
+	switch {
+	case IsNaN(n):
+		return n
+	case n.y > 710:
+		return Inf(+1)
+	case n.y < -746:
+		return Number{}
+	}
+	x, j := expReduced(n, nl)
+	return Ldexp(x, j)
+}
+
+// mulExt returns the product of a + al and b + bl, in extended precision.
+func mulExt(a Number, al float64, b Number, bl float64) (Number, float64) {
+	var e expansion
+	e.mulAdd(a.y, b.y)
+	e.mulAdd(a.y, b.x)
+	e.mulAdd(a.x, b.y)
+	e.mulAdd(a.x, b.x)
+	e.mulAdd(a.y, bl)
+	e.mulAdd(al, b.y)
+	return e.round()
+}
+
+// divExt returns the quotient of a + al and b + bl, in extended precision.
+func divExt(a Number, al float64, b Number, bl float64) (Number, float64) {
+	// The remainder of q is (a + al - q⋅(b + bl))/b.
+	q := Div(a, b)
+	var e expansion
+	e.add(a.y)
+	e.add(a.x)
+	e.add(al)
+	e.mulAdd(-q.y, b.y)
+	e.mulAdd(-q.y, b.x)
+	e.mulAdd(-q.x, b.y)
+	e.mulAdd(-q.x, b.x)
+	e.mulAdd(-q.y, bl)
+	e.add(-q.x * bl)
+	r, rl := e.round()
+	return q, (r.y + (r.x + rl)) / b.y
+}
+
+// frexpExt returns n + nl scaled by a power of two, 2⁻ᵉ, to be in [½, 1].
+func frexpExt(n Number, nl float64) (Number, float64, int) {
+	_, e := math.Frexp(n.y)
+	return Ldexp(n, -e), math.Ldexp(nl, -e), e
+}
+
 func isOddInteger(n Number) bool {
 	return isOddFloat(n.x) || isOddFloat(n.y)
 }
@@ -1,6 +1,11 @@
 package dbldbl
 
-import "testing"
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
 
 func TestPow(t *testing.T) {
 	tests := []struct {
@@ -112,7 +117,9 @@ func TestPow10_specials(t *testing.T) {
 		want Number
 	}{
 		{309, Inf(1)},
-		{-309, Number{}},
+		{-309, Float(1e-309)},
+		{-323, Float(1e-323)},
+		{-324, Number{}},
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
@@ -122,3 +129,104 @@ func TestPow10_specials(t *testing.T) {
 		})
 	}
 }
+
+func TestPowInt(t *testing.T) {
+	tests := []struct {
+		arg0 Number
+		arg1 int64
+		want Number
+	}{
+		{Float(10), 20, Number{1e20, 0}},
+		{Float(10), 40, Number{1e40, -0x1.0151182a7cp+78}},
+		{Float(3), 66, parse("30903154382632612361920641803529")},
+		{Float(-3), 3, Number{-27, 0}},
+		{Float(-2), -3, Number{-0.125, 0}},
+		{Float(2), -1074, Float(0x1p-1074)},
+		{Float(2), -1075, Number{}},
+		{Float(2), 1024, Inf(1)},
+		{Float(-2), 1025, Inf(-1)},
+		{Float(0.5), math.MinInt64, Inf(1)},
+		{Float(-1), math.MinInt64, Float(1)},
+		{Float(-1), math.MaxInt64, Float(-1)},
+		{Number{1, 0x1p-80}, math.MaxInt64, parse("1.000007629423635154471742357140259288")},
+		{Number{-1, -0x1p-80}, math.MaxInt64, parse("-1.000007629423635154471742357140259288")},
+		{Float(-zero), -3, Inf(-1)},
+		{Inf(-1), 3, Inf(-1)},
+		{NaN(), 0, Float(1)},
+		{NaN(), 2, NaN()},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if got := PowInt(tt.arg0, tt.arg1); !same(got, tt.want) {
+				t.Errorf("PowInt() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPow_big(t *testing.T) {
+	const prec = 3000
+	r := rand.New(rand.NewSource(1))
+
+	// check that |got - want| ≤ 2⁻¹⁰⁴⋅|want| + 2⁻¹⁰⁷³,
+	// or that got is Inf, and want overflows.
+	check := func(name string, got Number, want *big.Float, b Number, n any) {
+		t.Helper()
+		f, _ := want.Float64()
+		switch {
+		case math.IsInf(f, 0):
+			if !IsInf(got, int(math.Copysign(1, f))) {
+				t.Fatalf("%s(%#v, %v) = %#v, want %v", name, b, n, got, f)
+			}
+			return
+		}
+		var d, e big.Float
+		d.SetPrec(prec).Sub(got.toBig(), want).Abs(&d)
+		e.SetPrec(prec).Abs(want).SetMantExp(&e, -104)
+		e.Add(&e, big.NewFloat(0x1p-1073))
+		if d.Cmp(&e) > 0 {
+			t.Fatalf("%s(%#v, %v) = %#v, want %v", name, b, n, got, want.Text('g', 40))
+		}
+	}
+
+	for range 1000 {
+		var b Number
+		var n int64
+		switch r.Intn(3) {
+		case 0:
+			b = Make(math.Ldexp(r.Float64()+0.5, r.Intn(20)-10), math.Ldexp(r.Float64()-0.5, -60-r.Intn(10)))
+			n = r.Int63n(2000) - 1000
+		case 1:
+			// Close to 1.
+			k := r.Intn(100)
+			b = Make(1, math.Ldexp(r.Float64()-0.5, -k))
+			n = r.Int63n(1<<min(k+8, 62)) - 1<<min(k+7, 61)
+		default:
+			b = Make(math.Ldexp(r.Float64()+0.5, r.Intn(2000)-1000), math.Ldexp(r.Float64()-0.5, -60-r.Intn(1000)))
+			n = r.Int63n(10) - 5
+		}
+		if r.Intn(2) == 0 {
+			b = Neg(b)
+		}
+
+		// bⁿ, and b^(n/4) = ⁴√(bⁿ), by binary powering.
+		want, x := new(big.Float).SetPrec(prec).SetInt64(1), b.BigFloat(nil).SetPrec(prec)
+		for u := uint64(max(n, -n)); u != 0; u >>= 1 {
+			if u&1 != 0 {
+				want.Mul(want, x)
+			}
+			x.Mul(x, x)
+		}
+		if n < 0 {
+			want.Quo(new(big.Float).SetInt64(1), want)
+		}
+		check("PowInt", PowInt(b, n), want, b, n)
+
+		// Pow(b, ±½) is Sqrt(b) or InvSqrt(b).
+		if b.y > 0 && n != 2 && n != -2 {
+			want.Sqrt(want).Sqrt(want)
+			q := Ldexp(Int(n), -2)
+			check("Pow", Pow(b, q), want, b, q)
+		}
+	}
+}
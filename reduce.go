@@ -0,0 +1,96 @@
+package dbldbl
+
+import (
+	"math"
+	"math/big"
+)
+
+// trigReduce returns k and θ, such that n = k⋅π/2 + θ, with |θ| ≲ π/4,
+// and a bound on the absolute error of θ.
+func trigReduce(n Number) (k int, θ Number, err float64) {
+	if math.Abs(n.y) < 0x1p30 {
+		// Cody–Waite: π/2 is split into four parts, so the products
+		// are exact, and the parts add up to π/2 within 2⁻²¹⁶.
+		j := math.Round(n.y * (2 / math.Pi))
+		var e expansion
+		e.add(n.y)
+		e.add(n.x)
+		e.mulAdd(-j, halfPi0)
+		e.mulAdd(-j, halfPi1)
+		e.mulAdd(-j, halfPi2)
+		e.mulAdd(-j, halfPi3)
+		θ, _ = e.round()
+		return int(j), θ, e.bound() + math.Abs(j)*0x1p-216
+	}
+
+	// Payne–Hanek: for each part f = m⋅2ᵉ of n, the bits of 2/π
+	// that make f⋅2/π a multiple of 4 can be skipped,
+	// as can those below reduceBits fractional bits of the product.
+	var s, t big.Int
+	for _, f := range [2]float64{n.y, n.x} {
+		m, e := split(f)
+		j := e + reduceBits
+		if m == 0 || j <= 0 {
+			continue
+		}
+		t.Rsh(twoOfPiTable, uint(len(twoOfPiBits)*64-j))
+		t.And(&t, reduceMask)
+		t.Mul(&t, new(big.Int).SetUint64(m))
+		if f < 0 {
+			s.Sub(&s, &t)
+		} else {
+			s.Add(&s, &t)
+		}
+	}
+
+	// s is n⋅2/π modulo 4, with reduceBits fractional bits;
+	// round it to the nearest integer k, and keep the remainder.
+	s.And(&s, reduceMask)
+	t.Rsh(t.Add(&s, reduceHalf), reduceBits)
+	k = int(t.Int64())
+	s.Sub(&s, t.Lsh(&t, reduceBits))
+
+	// Besides the dropped bits, converting s, rounding π/2
+	// and the product each lose less than 2⁻¹⁰³⋅|θ|.
+	θ = Mul(Ldexp(FromBigInt(&s), -reduceBits), halfPi)
+	return k & 3, θ, math.Abs(θ.y)*0x1p-101 + 0x1p-266
+}
+
+// π/2 split into four parts, for Cody–Waite reduction.
+const (
+	halfPi0 = 0x1.921fb54442d18p+00
+	halfPi1 = 0x1.1a62633145c07p-54
+	halfPi2 = -0x1.f1976b7ed8fbcp-110
+	halfPi3 = 0x1.4cf98e804177dp-164
+)
+
+// reduceBits is the number of fractional bits kept by
+// Payne–Hanek reduction. The dropped bits of 2/π contribute
+// an absolute error less than 2⁻²⁶⁷ to n⋅2/π.
+const reduceBits = 320
+
+var (
+	reduceMask   = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), reduceBits+2), big.NewInt(1))
+	reduceHalf   = new(big.Int).Lsh(big.NewInt(1), reduceBits-1)
+	twoOfPiTable = fromWords(twoOfPiBits[:])
+)
+
+// fromWords returns the big-endian words w as a big.Int.
+func fromWords(w []uint64) *big.Int {
+	var z, t big.Int
+	for _, w := range w {
+		z.Or(z.Lsh(&z, 64), t.SetUint64(w))
+	}
+	return &z
+}
+
+// twoOfPiBits holds the leading 1344 fractional bits of 2/π,
+// which cover the exponent range of float64 plus reduceBits.
+var twoOfPiBits = [...]uint64{
+	0xa2f9836e4e441529, 0xfc2757d1f534ddc0, 0xdb6295993c439041, 0xfe5163abdebbc561,
+	0xb7246e3a424dd2e0, 0x06492eea09d1921c, 0xfe1deb1cb129a73e, 0xe88235f52ebb4484,
+	0xe99c7026b45f7e41, 0x3991d639835339f4, 0x9c845f8bbdf9283b, 0x1ff897ffde05980f,
+	0xef2f118b5a0a6d1f, 0x6d367ecf27cb09b7, 0x4f463f669e5fea2d, 0x7527bac7ebe5f17b,
+	0x3d0739f78a5292ea, 0x6bfb5fb11f8d5d08, 0x56033046fc7b6bab, 0xf0cfbc209af4361d,
+	0xa9e391615ee61b08,
+}
@@ -0,0 +1,97 @@
+package dbldbl
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// Value implements [database/sql/driver.Valuer].
+// It returns the shortest decimal string that [Parse] will return exactly,
+// as for [Number.MarshalText].
+//
+// Since [Number.Scan] implements [fmt.Scanner],
+// use [Number.SQLScanner] or [NullNumber] to scan database values.
+func (n Number) Value() (driver.Value, error) {
+	return FormatNumber(n, 'g', -1), nil
+}
+
+// SQLScanner returns a [database/sql.Scanner] that stores into n.
+//
+// Number can't implement [database/sql.Scanner] itself,
+// because its Scan method implements [fmt.Scanner], so scan into
+// n.SQLScanner() instead. It accepts the same values as [NullNumber.Scan],
+// but NULL is an error; use [NullNumber] for nullable columns.
+func (n *Number) SQLScanner() sql.Scanner {
+	return (*numberScanner)(n)
+}
+
+// numberScanner is a Number that implements [database/sql.Scanner].
+type numberScanner Number
+
+func (s *numberScanner) Scan(value any) error {
+	v, ok, err := scanValue(value, "Number")
+	switch {
+	case err != nil:
+		return err
+	case !ok:
+		return errors.New("dbldbl: cannot scan NULL into Number")
+	}
+	*s = numberScanner(v)
+	return nil
+}
+
+// NullNumber represents a Number that may be null.
+// NullNumber implements the [database/sql.Scanner] interface
+// so it can be used as a scan destination, similar to [database/sql.NullFloat64].
+type NullNumber struct {
+	Number Number
+	Valid  bool // Valid is true if Number is not NULL
+}
+
+// Scan implements the [database/sql.Scanner] interface.
+//
+// It accepts float64 and int64 values, which it converts exactly,
+// and []byte and string values, which it converts with [Parse].
+func (n *NullNumber) Scan(value any) error {
+	v, ok, err := scanValue(value, "NullNumber")
+	if err != nil {
+		return err
+	}
+	*n = NullNumber{v, ok}
+	return nil
+}
+
+// scanValue converts a database value to a Number,
+// and reports whether the value was not NULL.
+func scanValue(value any, dest string) (Number, bool, error) {
+	var v Number
+	var err error
+	switch value := value.(type) {
+	case nil:
+		return Number{}, false, nil
+	case float64:
+		v = Float(value)
+	case int64:
+		v = Int(value)
+	case []byte:
+		v, err = Parse(string(value))
+	case string:
+		v, err = Parse(value)
+	default:
+		return Number{}, false, fmt.Errorf("dbldbl: cannot scan %T into %s", value, dest)
+	}
+	if err != nil {
+		return Number{}, false, err
+	}
+	return v, true, nil
+}
+
+// Value implements the [database/sql/driver.Valuer] interface.
+func (n NullNumber) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Number.Value()
+}
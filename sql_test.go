@@ -0,0 +1,147 @@
+package dbldbl
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+var (
+	_ driver.Valuer = Number{}
+	_ driver.Valuer = NullNumber{}
+	_ sql.Scanner   = &NullNumber{}
+	_ sql.Scanner   = new(Number).SQLScanner()
+)
+
+func TestNumber_Value(t *testing.T) {
+	tests := []struct {
+		arg  Number
+		want string
+	}{
+		{Number{}, "0"},
+		{Number{1.5, 0}, "1.5"},
+		{Number{1, 0x1p-60}, "1.0000000000000000008673617379884035"},
+		{Neg(Pi), "-3.1415926535897932384626433832795"},
+		{Inf(+1), "+Inf"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			v, err := tt.arg.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, ok := v.(string); !ok || got != tt.want {
+				t.Errorf("Value() = %#v, want %q", v, tt.want)
+			}
+		})
+	}
+}
+
+func TestNullNumber_Scan(t *testing.T) {
+	tests := []struct {
+		arg  any
+		want NullNumber
+	}{
+		{nil, NullNumber{}},
+		{float64(0.1), NullNumber{Float(0.1), true}},
+		{int64(-1e18 + 1), NullNumber{Number{-1e18, 1}, true}},
+		{"0.1", NullNumber{parse("0.1"), true}},
+		{[]byte("3.1415926535897932384626433832795"), NullNumber{Pi, true}},
+		{"NaN", NullNumber{NaN(), true}},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got := NullNumber{Pi, true}
+			if err := got.Scan(tt.arg); err != nil {
+				t.Fatal(err)
+			}
+			if got.Valid != tt.want.Valid || !same(got.Number, tt.want.Number) {
+				t.Errorf("Scan() = %#v, want %#v", got, tt.want)
+			}
+
+			v, err := got.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var back NullNumber
+			if err := back.Scan(v); err != nil {
+				t.Fatal(err)
+			}
+			if back.Valid != got.Valid || !same(back.Number, got.Number) {
+				t.Errorf("Scan(Value()) = %#v, want %#v", back, got)
+			}
+		})
+	}
+}
+
+func TestNullNumber_Scan_errors(t *testing.T) {
+	tests := []struct {
+		arg any
+		err error
+	}{
+		{"x", strconv.ErrSyntax},
+		{[]byte("1e400"), strconv.ErrRange},
+		{true, nil},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got := NullNumber{Pi, true}
+			err := got.Scan(tt.arg)
+			if err == nil || tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("Scan() error = %v, want %v", err, tt.err)
+			}
+			if !got.Valid || !same(got.Number, Pi) {
+				t.Errorf("Scan() = %#v, want unchanged", got)
+			}
+		})
+	}
+}
+
+func TestNumber_SQLScanner(t *testing.T) {
+	tests := []struct {
+		arg  any
+		want Number
+	}{
+		{float64(0.1), Float(0.1)},
+		{int64(-1e18 + 1), Number{-1e18, 1}},
+		{"0.1", parse("0.1")},
+		{[]byte("3.1415926535897932384626433832795"), Pi},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			var got Number
+			if err := got.SQLScanner().Scan(tt.arg); err != nil {
+				t.Fatal(err)
+			}
+			if !same(got, tt.want) {
+				t.Errorf("Scan() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNumber_SQLScanner_errors(t *testing.T) {
+	tests := []struct {
+		arg any
+		err error
+	}{
+		{nil, nil},
+		{"x", strconv.ErrSyntax},
+		{[]byte("1e400"), strconv.ErrRange},
+		{true, nil},
+	}
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			got := Pi
+			err := got.SQLScanner().Scan(tt.arg)
+			if err == nil || tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("Scan() error = %v, want %v", err, tt.err)
+			}
+			if !same(got, Pi) {
+				t.Errorf("Scan() = %#v, want unchanged", got)
+			}
+		})
+	}
+}
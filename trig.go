@@ -12,17 +12,21 @@ func Sincos(n Number) (sin, cos Number) {
 	}
 
 	// Range reduction modulo π/2.
-	k := Round(Mul(n, twoOfPi))
-	t := Sub(n, Mul(k, halfPi))
+	k, t, _ := trigReduce(n)
+	sin, cos = sincos(t)
+	return rotate(sin, cos, k)
+}
 
+// sincos returns the sine and cosine of θ, for |θ| ≤ π/4.
+func sincos(θ Number) (sin, cos Number) {
 	// Halve the angle until it is less than 2⁻⁵³.
 	var halvings int8
-	if _, e := math.Frexp(t.y); t.y != 0 && e > -53 {
+	if _, e := math.Frexp(θ.y); θ.y != 0 && e > -53 {
 		halvings = int8(53 + e)
 	}
 
 	// For |θ|<2⁻⁵³ these are accurate to 107 bits.
-	sin = shift(t, -halvings) // sin(θ) ≈ θ
+	sin = shift(θ, -halvings) // sin(θ) ≈ θ
 	cos = Float(1)            // cos(θ) ≈ 1
 
 	// Double-angle formulae.
@@ -31,10 +35,13 @@ func Sincos(n Number) (sin, cos Number) {
 		sin = shift(Mul(s, c), 1)           // sin(2⋅t) = 2⋅sin(θ)⋅cos(θ)
 		cos = SubFloat(1, shift(Sqr(s), 1)) // cos(2⋅t) = 1 - 2⋅sin²(θ)
 	}
+	return sin, cos
+}
 
-	yi, _ := math.Modf(k.y)
-	xi, _ := math.Modf(k.x)
-	switch (int64(xi) | int64(yi)) & 3 {
+// rotate returns the sine and cosine of θ + k⋅π/2,
+// given the sine and cosine of θ.
+func rotate(sin, cos Number, k int) (Number, Number) {
+	switch k & 3 {
 	default:
 		return sin, cos
 	case 1:
@@ -132,3 +139,162 @@ func Atan2(y, x Number) Number {
 		return Sub(z, Pi)
 	}
 }
+
+// Sincospi returns Sinpi(n), Cospi(n) (approximate).
+func Sincospi(n Number) (sin, cos Number) {
+	switch {
+	case n.y == 0:
+		return n, Float(1)
+	case !isFinite(n.y):
+		return NaN(), NaN()
+	}
+
+	// Exact range reduction:
+	// π⋅n = (k + f)⋅π/2, with k an integer, and |f| ≤ ½.
+	r := shift(AddFloats(math.Remainder(n.y, 2), math.Remainder(n.x, 2)), 1)
+	k := math.Round(r.y)
+	f := AddFloat(r, -k)
+
+	switch {
+	case f.y == 0:
+		sin, cos = Number{}, Float(1)
+	case f.x == 0 && math.Abs(f.y) == 0.5:
+		sin, cos = shift(Copysign(Sqrt2, f), -1), shift(Sqrt2, -1)
+	default:
+		sin, cos = sincos(Mul(f, halfPi))
+	}
+	return signedZeros(n, sin, cos, int(k))
+}
+
+// Sinpi returns the sine of π⋅n (approximate).
+// It's exact if n is a multiple of ½.
+func Sinpi(n Number) Number {
+	sin, _ := Sincospi(n)
+	return sin
+}
+
+// Cospi returns the cosine of π⋅n (approximate).
+// It's exact if n is a multiple of ½.
+func Cospi(n Number) Number {
+	_, cos := Sincospi(n)
+	return cos
+}
+
+// Tanpi returns the tangent of π⋅n (approximate).
+// It's exact if n is a multiple of ¼.
+func Tanpi(n Number) Number {
+	sin, cos := Sincospi(n)
+	return Div(sin, cos)
+}
+
+// Sind returns the sine of the degree argument n (approximate).
+// It's exact if n is a multiple of 180, or an odd multiple of 30.
+func Sind(n Number) Number {
+	sin, _ := sincosd(n)
+	return sin
+}
+
+// Cosd returns the cosine of the degree argument n (approximate).
+// It's exact if n is a multiple of 60 or 90.
+func Cosd(n Number) Number {
+	_, cos := sincosd(n)
+	return cos
+}
+
+func sincosd(n Number) (sin, cos Number) {
+	switch {
+	case n.y == 0:
+		return n, Float(1)
+	case !isFinite(n.y):
+		return NaN(), NaN()
+	}
+
+	// Exact range reduction:
+	// n = 90⋅k + t, with k an integer, and |t| ≤ 45.
+	r := AddFloats(math.Remainder(n.y, 360), math.Remainder(n.x, 360))
+	k := math.Round(r.y / 90)
+	t := AddFloat(r, -90*k)
+
+	switch {
+	case t.y == 0:
+		sin, cos = Number{}, Float(1)
+	case t.x == 0 && math.Abs(t.y) == 45:
+		sin, cos = shift(Copysign(Sqrt2, t), -1), shift(Sqrt2, -1)
+	case t.x == 0 && math.Abs(t.y) == 30:
+		sin, cos = Copysign(Float(0.5), t), Sqrt(Float(0.75))
+	default:
+		sin, cos = sincos(Mul(t, degree))
+	}
+	return signedZeros(n, sin, cos, int(k))
+}
+
+// signedZeros returns the sine and cosine of θ + k⋅π/2,
+// given those of θ, for the angle n = θ + k⋅π/2 in some unit.
+// A zero sine has the sign of n, and a zero cosine is positive.
+func signedZeros(n, sin, cos Number, k int) (Number, Number) {
+	sin, cos = rotate(sin, cos, k)
+	if sin.y == 0 {
+		sin = Number{y: math.Copysign(0, n.y)}
+	}
+	if cos.y == 0 {
+		cos = Number{}
+	}
+	return sin, cos
+}
+
+// Asinpi returns the arcsine of n, divided by π (approximate).
+// It's exact if n is 0 or ±1.
+func Asinpi(n Number) Number {
+	return Atan2pi(n, Sqrt(SubFloat(1, Sqr(n))))
+}
+
+// Acospi returns the arccosine of n, divided by π (approximate).
+// It's exact if n is 0 or ±1.
+func Acospi(n Number) Number {
+	return Atan2pi(Sqrt(SubFloat(1, Sqr(n))), n)
+}
+
+// Atanpi returns the arctangent of n, divided by π (approximate).
+// It's exact if n is 0, ±1, or ±Inf.
+func Atanpi(n Number) Number {
+	return Atan2pi(n, Float(1))
+}
+
+// Atan2pi returns Atan2(y, x), divided by π (approximate).
+// It's exact if y or x are 0 or ±Inf, or if |y| = |x|.
+func Atan2pi(y, x Number) Number {
+	switch {
+	case IsNaN(y) || IsNaN(x):
+		return NaN()
+	case y.y == 0 && x.y == 0:
+		if !Signbit(x) {
+			return y
+		}
+		return Copysign(Float(1), y)
+	case IsInf(y, 0) && IsInf(x, 0):
+		y = Number{y: math.Copysign(1, y.y)}
+		x = Number{y: math.Copysign(1, x.y)}
+	}
+
+	// Reduce to the first octant.
+	var z Number
+	ay, ax := Abs(y), Abs(x)
+	switch c := Cmp(ay, ax); {
+	case c == 0:
+		z = Float(0.25)
+	case c < 0:
+		z = Div(Atan(Div(ay, ax)), Pi)
+	default:
+		z = SubFloat(0.5, Div(Atan(Div(ax, ay)), Pi))
+	}
+	if Signbit(x) {
+		z = SubFloat(1, z)
+	}
+	return Copysign(z, y)
+}
+
+// Atan2d returns Atan2(y, x), in degrees (approximate).
+// It's exact if y or x are 0 or ±Inf, or if |y| = |x|.
+func Atan2d(y, x Number) Number {
+	return MulFloat(Atan2pi(y, x), 180)
+}
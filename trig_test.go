@@ -2,6 +2,8 @@ package dbldbl
 
 import (
 	"math"
+	"math/big"
+	"math/rand"
 	"testing"
 )
 
@@ -11,10 +13,15 @@ func TestSin(t *testing.T) {
 		want string
 	}{
 		{Div(Pi, Float(6)), "0.5"}, // sin(π/6) = 1/2
-		{Div(Pi, Float(4)), "0.7071067811865475244008443621048490392848359376"}, // sin(π/4) = √2/2, https://oeis.org/A010503
-		{Div(Pi, Float(3)), "0.8660254037844386467637231707529361834714026269"}, // sin(π/3) = √3/2, https://oeis.org/A010527
-		{Float(1), "0.8414709848078965066525023216302989996225630607983710656"}, // https://oeis.org/A049469
-		{Float(-1), "-0.84147098480789650665250232163029899962256306079837106"}, //
+		{Div(Pi, Float(4)), "0.7071067811865475244008443621048490392848359376"},         // sin(π/4) = √2/2, https://oeis.org/A010503
+		{Div(Pi, Float(3)), "0.8660254037844386467637231707529361834714026269"},         // sin(π/3) = √3/2, https://oeis.org/A010527
+		{Float(1), "0.8414709848078965066525023216302989996225630607983710656"},         // https://oeis.org/A049469
+		{Float(-1), "-0.84147098480789650665250232163029899962256306079837106"},         //
+		{Pi, "-2.9947698097183395546415942678754501899733394e-33"},                      // sin(Pi) = π - Pi
+		{twoPi, "5.9895396194366791092831885357509003799466788e-33"},                    //
+		{Float(1e22), "-0.8522008497671888017727058937530293682617621504"},              //
+		{Float(0x1p1023), "0.5631277798508840134529434079444683477103854907"},           //
+		{Number{0x1p1023, 0x1p970}, "-0.368082143146399534686283156937044528172928526"}, //
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
@@ -35,8 +42,6 @@ func TestSin_specials(t *testing.T) {
 		{NaN(), Number{y: math.NaN()}},
 		{Inf(+1), Number{y: math.NaN()}},
 		{Inf(-1), Number{y: math.NaN()}},
-		{Pi, Number{y: -zero}}, // sin(π) = ±0
-		{twoPi, Number{}},      // sin(2π) = 0
 		{halfPi, Number{y: 1}}, // sin(π/2) = 1
 	}
 	for _, tt := range tests {
@@ -58,6 +63,7 @@ func TestCos(t *testing.T) {
 		{Div(Pi, Float(6)), "0.8660254037844386467637231707529361834714026269"}, // cos(π/6) = √3/2, https://oeis.org/A010527
 		{Float(1), "0.5403023058681397174009366074429766037323104206179222276"}, // https://oeis.org/A049470
 		{Float(-1), "0.540302305868139717400936607442976603732310420617922227"}, //
+		{halfPi, "-1.4973849048591697773207971339377250949866697e-33"},          // cos(Pi/2) = (Pi - π)/2
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
@@ -77,10 +83,8 @@ func TestCos_specials(t *testing.T) {
 		{NaN(), Number{y: math.NaN()}},
 		{Inf(+1), Number{y: math.NaN()}},
 		{Inf(-1), Number{y: math.NaN()}},
-		{Pi, Number{y: -1}},        // cos(π) = -1
-		{twoPi, Number{y: 1}},      // cos(2π) = 1
-		{halfPi, Number{y: -zero}}, // cos(π/2) = ±0
-
+		{Pi, Number{y: -1}},   // cos(π) = -1
+		{twoPi, Number{y: 1}}, // cos(2π) = 1
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
@@ -100,6 +104,8 @@ func TestTan(t *testing.T) {
 		{Div(Pi, Float(3)), "1.7320508075688772935274463415058723669428052538"}, // tan(π/3) = √3, https://oeis.org/A002194
 		{Float(1), "1.5574077246549022305069748074583601730872507723815200383"}, // https://oeis.org/A049471
 		{Float(-1), "-1.55740772465490223050697480745836017308725077238152003"}, //
+		{Pi, "2.9947698097183395546415942678754501899733394e-33"},               // tan(Pi) = Pi - π
+		{twoPi, "5.9895396194366791092831885357509003799466788e-33"},            //
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
@@ -120,8 +126,6 @@ func TestTan_specials(t *testing.T) {
 		{NaN(), Number{y: math.NaN()}},
 		{Inf(+1), Number{y: math.NaN()}},
 		{Inf(-1), Number{y: math.NaN()}},
-		{Pi, Number{}},    // tan(π) = 0
-		{twoPi, Number{}}, // tan(2π) = 0
 	}
 	for _, tt := range tests {
 		t.Run("", func(t *testing.T) {
@@ -294,3 +298,237 @@ func TestAtan2_specials(t *testing.T) {
 		})
 	}
 }
+
+func TestSinpi(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Number
+		want string
+	}{
+		{"Sinpi(⅛)", Sinpi(Float(0.125)), "0.382683432365089771728459984030398866761344562"},
+		{"Cospi(⅛)", Cospi(Float(0.125)), "0.923879532511286756128183189396788286822416626"},
+		{"Tanpi(⅛)", Tanpi(Float(0.125)), "0.414213562373095048801688724209698078569671875"}, // √2 - 1
+		{"Sinpi(¼)", Sinpi(Float(0.25)), "0.7071067811865475244008443621048490392848359376"}, // √2/2, https://oeis.org/A010503
+		{"Sinpi(-0.3)", Sinpi(Float(-0.3)), "-0.809016994374947403601116766533088437244566105"},
+		{"Cospi(-0.3)", Cospi(Float(-0.3)), "0.587785252292473157386154844979129154121384277"},
+		{"Sinpi(2⁷⁰+⅛)", Sinpi(Number{0x1p70, 0.125}), "0.382683432365089771728459984030398866761344562"},
+		{"Cospi(2⁷⁰+1+⅛)", Cospi(Number{0x1p70, 1.125}), "-0.923879532511286756128183189396788286822416626"},
+		{"Sind(1)", Sind(Float(1)), "0.0174524064372835128194189785163161924722527203"},
+		{"Cosd(1)", Cosd(Float(1)), "0.999847695156391239157011558813914851692740311"},
+		{"Sind(100)", Sind(Float(100)), "0.984807753012208059366743024589523013670643252"},
+		{"Sind(-260)", Sind(Float(-260)), "0.984807753012208059366743024589523013670643252"},
+		{"Cosd(360⋅2⁶⁰-1)", Cosd(Number{360 * 0x1p60, -1}), "0.999847695156391239157011558813914851692740311"},
+		{"Sinpi(¼+δ)", Sinpi(Number{0.25, 0x1.79ca10c924223p-67}), "0.707106781186547524423058776795640869301178427"},
+		{"Cospi(¼+δ)", Cospi(Number{0.25, 0x1.79ca10c924223p-67}), "0.707106781186547524378629947414057209267795562"},
+		{"Sinpi(-¼+δ)", Sinpi(Number{-0.25, 0x1.79ca10c924223p-67}), "-0.707106781186547524378629947414057209267795562"},
+		{"Sind(30+δ)", Sind(Number{30, 1e-20}), "0.500000000000000000000151149947019518145926085"},
+		{"Cosd(30+δ)", Cosd(Number{30, 1e-20}), "0.866025403784438646763635904290336466997342709"},
+		{"Sind(-30+δ)", Sind(Number{-30, 1e-20}), "-0.49999999999999999999984885005298048185407390"},
+		{"Sind(45+δ)", Sind(Number{45, 1e-20}), "0.707106781186547524400967775519797882784928657"},
+		{"Cosd(45+δ)", Cosd(Number{45, 1e-20}), "0.707106781186547524400720948689900195784743197"},
+		{"Atanpi(½)", Atanpi(Float(0.5)), "0.147583617650433274175401076224740525951134524"},
+		{"Atanpi(3)", Atanpi(Float(3)), "0.397583617650433274175401076224740525951134524"},
+		{"Asinpi(¼)", Asinpi(Float(0.25)), "0.0804306232551662437709501933284842555840644312"},
+		{"Acospi(-¼)", Acospi(Float(-0.25)), "0.5804306232551662437709501933284842555840644312"},
+		{"Atan2pi(-1, -2)", Atan2pi(Float(-1), Float(-2)), "-0.852416382349566725824598923775259474048865476"},
+		{"Atan2d(1, 2)", Atan2d(Float(1), Float(2)), "26.5650511770779893515721937204532946712042143"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !near(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSinpi_specials(t *testing.T) {
+	half := shift(Sqrt2, -1)
+	tests := []struct {
+		name string
+		got  Number
+		want Number
+	}{
+		{"Sinpi(0)", Sinpi(Number{}), Number{}},
+		{"Sinpi(-0)", Sinpi(Float(-zero)), Float(-zero)},
+		{"Sinpi(1)", Sinpi(Float(1)), Number{}},
+		{"Sinpi(-1)", Sinpi(Float(-1)), Float(-zero)},
+		{"Sinpi(2)", Sinpi(Float(2)), Number{}},
+		{"Sinpi(½)", Sinpi(Float(0.5)), Float(1)},
+		{"Sinpi(-½)", Sinpi(Float(-0.5)), Float(-1)},
+		{"Sinpi(2⁶⁰+1.5)", Sinpi(Number{0x1p60, 1.5}), Float(-1)},
+		{"Sinpi(2¹⁰⁰⁰)", Sinpi(Float(0x1p1000)), Number{}},
+		{"Sinpi(¼)", Sinpi(Float(0.25)), half},
+		{"Sinpi(Inf)", Sinpi(Inf(+1)), NaN()},
+		{"Sinpi(NaN)", Sinpi(NaN()), NaN()},
+		{"Cospi(0)", Cospi(Number{}), Float(1)},
+		{"Cospi(½)", Cospi(Float(0.5)), Number{}},
+		{"Cospi(-½)", Cospi(Float(-0.5)), Number{}},
+		{"Cospi(1)", Cospi(Float(1)), Float(-1)},
+		{"Cospi(-¾)", Cospi(Float(-0.75)), Neg(half)},
+		{"Cospi(Inf)", Cospi(Inf(-1)), NaN()},
+		{"Tanpi(¼)", Tanpi(Float(0.25)), Float(1)},
+		{"Tanpi(-¼)", Tanpi(Float(-0.25)), Float(-1)},
+		{"Tanpi(½)", Tanpi(Float(0.5)), Inf(+1)},
+		{"Tanpi(3/2)", Tanpi(Float(1.5)), Inf(-1)},
+		{"Tanpi(1)", Tanpi(Float(1)), Float(-zero)},
+		{"Tanpi(2)", Tanpi(Float(2)), Number{}},
+		{"Sind(0)", Sind(Number{}), Number{}},
+		{"Sind(30)", Sind(Float(30)), Float(0.5)},
+		{"Sind(-150)", Sind(Float(-150)), Float(-0.5)},
+		{"Sind(45)", Sind(Float(45)), half},
+		{"Sind(90)", Sind(Float(90)), Float(1)},
+		{"Sind(180)", Sind(Float(180)), Number{}},
+		{"Sind(-180)", Sind(Float(-180)), Float(-zero)},
+		{"Sind(360⋅2⁶⁰+30)", Sind(Number{360 * 0x1p60, 30}), Float(0.5)},
+		{"Sind(Inf)", Sind(Inf(+1)), NaN()},
+		{"Cosd(60)", Cosd(Float(60)), Float(0.5)},
+		{"Cosd(90)", Cosd(Float(90)), Number{}},
+		{"Cosd(-270)", Cosd(Float(-270)), Number{}},
+		{"Cosd(180)", Cosd(Float(180)), Float(-1)},
+		{"Cosd(NaN)", Cosd(NaN()), NaN()},
+		{"Asinpi(1)", Asinpi(Float(1)), Float(0.5)},
+		{"Asinpi(-1)", Asinpi(Float(-1)), Float(-0.5)},
+		{"Asinpi(-0)", Asinpi(Float(-zero)), Float(-zero)},
+		{"Asinpi(2)", Asinpi(Float(2)), NaN()},
+		{"Acospi(1)", Acospi(Float(1)), Number{}},
+		{"Acospi(-1)", Acospi(Float(-1)), Float(1)},
+		{"Acospi(0)", Acospi(Number{}), Float(0.5)},
+		{"Atanpi(1)", Atanpi(Float(1)), Float(0.25)},
+		{"Atanpi(-Inf)", Atanpi(Inf(-1)), Float(-0.5)},
+		{"Atanpi(-0)", Atanpi(Float(-zero)), Float(-zero)},
+		{"Atanpi(NaN)", Atanpi(NaN()), NaN()},
+		{"Atan2pi(0, -0)", Atan2pi(Number{}, Float(-zero)), Float(1)},
+		{"Atan2pi(-0, -0)", Atan2pi(Float(-zero), Float(-zero)), Float(-1)},
+		{"Atan2pi(-0, 0)", Atan2pi(Float(-zero), Number{}), Float(-zero)},
+		{"Atan2pi(1, -1)", Atan2pi(Float(1), Float(-1)), Float(0.75)},
+		{"Atan2pi(-Inf, -Inf)", Atan2pi(Inf(-1), Inf(-1)), Float(-0.75)},
+		{"Atan2pi(1, -Inf)", Atan2pi(Float(1), Inf(-1)), Float(1)},
+		{"Atan2pi(-1, 0)", Atan2pi(Float(-1), Number{}), Float(-0.5)},
+		{"Atan2pi(NaN, 1)", Atan2pi(NaN(), Float(1)), NaN()},
+		{"Atan2d(1, 1)", Atan2d(Float(1), Float(1)), Float(45)},
+		{"Atan2d(0, -1)", Atan2d(Number{}, Float(-1)), Float(180)},
+		{"Atan2d(-1, 0)", Atan2d(Float(-1), Number{}), Float(-90)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !same(tt.got, tt.want) {
+				t.Errorf("got %#v, want %#v", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSincospi_big(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for range 1000 {
+		n := Make(math.Ldexp(r.Float64()-0.5, r.Intn(8)-6), math.Ldexp(r.Float64()-0.5, -60-r.Intn(10)))
+		sin, cos := Sincospi(n)
+
+		// Compare with Sincos, for |π⋅n| ≤ π/2.
+		s, c := Sincos(Mul(n, Pi))
+		if d := Abs(Sub(sin, s)); d.y > 0x1p-104 {
+			t.Fatalf("Sinpi(%#v) = %#v, want %#v", n, sin, s)
+		}
+		if d := Abs(Sub(cos, c)); d.y > 0x1p-104 {
+			t.Fatalf("Cospi(%#v) = %#v, want %#v", n, cos, c)
+		}
+
+		// The reduction is exact.
+		sin, cos = Sincospi(Float(n.y))
+		m := float64(2 * (r.Int63n(1<<50) - 1<<49))
+		if s, c := Sincospi(AddFloats(m, n.y)); s != sin || c != cos {
+			t.Fatalf("Sincospi(%#v) = %#v, %#v, want %#v, %#v", AddFloats(m, n.y), s, c, sin, cos)
+		}
+		d := Float(n.y * 180)
+		m = float64(360 * (r.Int63n(1<<40) - 1<<39))
+		if s, want := Sind(AddFloats(m, d.y)), Sin(Mul(d, degree)); s != Sind(d) || Cmp(Abs(Sub(s, want)), Float(0x1p-104)) > 0 {
+			t.Fatalf("Sind(%#v) = %#v, want %#v", AddFloats(m, d.y), s, want)
+		}
+	}
+}
+
+func TestSincos_big(t *testing.T) {
+	const prec = 1500
+	pi := bigPi(prec)
+
+	r := rand.New(rand.NewSource(1))
+	for i := range 400 {
+		// Half the cases exercise the reduction of moderate arguments.
+		e := r.Intn(1024)
+		if i%2 == 0 {
+			e = r.Intn(32)
+		}
+		n := Make(math.Ldexp(1+r.Float64(), e), math.Ldexp(r.Float64()-0.5, e-53))
+		if r.Intn(2) == 0 {
+			n = Neg(n)
+		}
+		sin, cos := Sincos(n)
+
+		var b big.Float
+		s, c := bigSincos(n.BigFloat(b.SetPrec(prec)), pi)
+		if !closeBig(sin, s) {
+			t.Fatalf("Sin(%#v) = %v, want %v", n, sin, s)
+		}
+		if !closeBig(cos, c) {
+			t.Fatalf("Cos(%#v) = %v, want %v", n, cos, c)
+		}
+	}
+}
+
+// closeBig reports whether n is within 2⁻¹⁰¹ relative error of want, like near.
+func closeBig(n Number, want *big.Float) bool {
+	var d, tol big.Float
+	d.SetPrec(want.Prec()).Sub(n.BigFloat(&d), want)
+	tol.SetPrec(want.Prec()).SetMantExp(&tol, 0).Abs(want)
+	tol.SetMantExp(&tol, -101)
+	return d.Abs(&d).Cmp(&tol) <= 0
+}
+
+// bigPi returns π to prec bits, using Machin's formula.
+func bigPi(prec uint) *big.Float {
+	atanInv := func(k int64) *big.Float {
+		var sum, term, t big.Float
+		sum.SetPrec(prec)
+		term.SetPrec(prec).Quo(t.SetInt64(1), big.NewFloat(float64(k)))
+		for i := int64(1); term.Sign() != 0 && term.MantExp(nil) > -int(prec); i += 2 {
+			t.SetPrec(prec).Quo(&term, big.NewFloat(float64(i)))
+			if i%4 == 1 {
+				sum.Add(&sum, &t)
+			} else {
+				sum.Sub(&sum, &t)
+			}
+			term.Quo(&term, big.NewFloat(float64(k*k)))
+		}
+		return &sum
+	}
+	var pi big.Float
+	pi.SetPrec(prec).Sub(
+		new(big.Float).SetPrec(prec).Mul(atanInv(5), big.NewFloat(16)),
+		new(big.Float).SetPrec(prec).Mul(atanInv(239), big.NewFloat(4)))
+	return &pi
+}
+
+// bigSincos returns the sine and cosine of x, to the precision of x,
+// using the Taylor series after reducing x modulo 2π.
+func bigSincos(x, pi *big.Float) (sin, cos *big.Float) {
+	prec := x.Prec()
+	var twoPi, r, r2, term big.Float
+	twoPi.SetPrec(prec).Mul(pi, big.NewFloat(2))
+	q, _ := r.SetPrec(prec).Quo(x, &twoPi).Int(nil)
+	r.Sub(x, r.Mul(r.SetInt(q), &twoPi))
+	r2.SetPrec(prec).Mul(&r, &r)
+
+	sin = new(big.Float).SetPrec(prec)
+	cos = new(big.Float).SetPrec(prec)
+	term.SetPrec(prec).SetInt64(1)
+	for i := int64(1); term.Sign() != 0 && term.MantExp(nil) > -int(prec); i += 2 {
+		cos.Add(cos, &term)
+		term.Mul(&term, &r)
+		term.Quo(&term, big.NewFloat(float64(i)))
+		sin.Add(sin, &term)
+		term.Mul(&term, &r)
+		term.Quo(&term, big.NewFloat(float64(-i-1)))
+	}
+	return sin, cos
+}